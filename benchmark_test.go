@@ -0,0 +1,113 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+)
+
+const benchmarkChainDepth = 50
+
+// deepChain builds a chain of errorsx errors, alternating with fmt wrappers,
+// whose types are inferred from their IDs.
+func deepChain(depth int) error {
+	inferer := errorsx.IDContainsInferer(map[string]errorsx.ErrorType{
+		"db":      errorsx.TypeUnavailable,
+		"timeout": errorsx.TypeTimeout,
+	})
+
+	var err error = errorsx.New("db.connection_refused", errorsx.WithTypeInferer(inferer))
+	for i := 0; i < depth; i++ {
+		err = errorsx.New(fmt.Sprintf("layer%d.failed", i), errorsx.WithTypeInferer(inferer)).WithCause(err)
+		err = fmt.Errorf("layer %d: %w", i, err)
+	}
+	return err
+}
+
+// benchmarkTypeCache runs fn with warm type caches, and as a baseline with
+// the caches invalidated before every call, so that every Type() infers again.
+func benchmarkTypeCache(b *testing.B, fn func()) {
+	b.Run("Cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			fn()
+		}
+	})
+	b.Run("Uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			// Installing a rule set invalidates every cached type.
+			errorsx.SetRuleSet(nil)
+			fn()
+		}
+	})
+}
+
+func BenchmarkHasType_DeepChain(b *testing.B) {
+	err := deepChain(benchmarkChainDepth)
+	benchmarkTypeCache(b, func() {
+		_ = errorsx.HasType(err, errorsx.TypeTimeout)
+	})
+}
+
+func BenchmarkFilterByType_DeepChain(b *testing.B) {
+	err := deepChain(benchmarkChainDepth)
+	benchmarkTypeCache(b, func() {
+		_ = errorsx.FilterByType(err, errorsx.TypeServerError)
+	})
+}
+
+func BenchmarkType_Parallel(b *testing.B) {
+	errorsx.SetGlobalTypeInferer(errorsx.IDContainsInferer(map[string]errorsx.ErrorType{"db": errorsx.TypeUnavailable}))
+	defer errorsx.ClearGlobalTypeInferer()
+	err := errorsx.New("db.connection_refused")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = err.Type()
+		}
+	})
+}
+
+func BenchmarkRuleMatcher_ManyRules(b *testing.B) {
+	rules := make(errorsx.Rules, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		rules = append(rules, errorsx.Rule{
+			Pattern: fmt.Sprintf("service%d.*.failed", i),
+			Type:    errorsx.ErrorType(fmt.Sprintf("test.service%d", i)),
+		})
+	}
+	rules = append(rules, errorsx.Rule{Pattern: "**.timeout", Type: errorsx.TypeTimeout})
+	matcher := errorsx.CompileRules(rules, errorsx.MostSpecificMatch)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = matcher.Match("service999.db.failed")
+	}
+}
+
+func BenchmarkWithCause_StackCapture(b *testing.B) {
+	cause := errors.New("cause")
+	modes := []struct {
+		name string
+		mode errorsx.StackCaptureMode
+	}{
+		{"Full", errorsx.StackCaptureFull},
+		{"Caller", errorsx.StackCaptureCaller},
+		{"Off", errorsx.StackCaptureOff},
+	}
+	for _, m := range modes {
+		f := errorsx.NewFactory(errorsx.Config{StackCapture: m.mode})
+		b.Run(m.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = f.New("db.failed").WithCause(cause)
+			}
+		})
+	}
+}
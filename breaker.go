@@ -0,0 +1,396 @@
+package errorsx
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitOpenID is the ID of errors returned by a Breaker while it rejects calls.
+const CircuitOpenID = "errorsx.circuit_open"
+
+// ErrCircuitOpen is a sentinel error for comparing against errors returned by
+// an open Breaker.
+//
+// Example:
+//
+//	if errors.Is(err, errorsx.ErrCircuitOpen) {
+//		// Serve a fallback response
+//	}
+var ErrCircuitOpen = builtinFactory.New(CircuitOpenID) //nolint:gochecknoglobals
+
+const (
+	defaultBreakerThreshold = 5
+	defaultBreakerWindow    = time.Minute
+	defaultBreakerCooldown  = 30 * time.Second
+	defaultBreakerProbes    = 1
+)
+
+// BreakerState represents the state of a Breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through and counts failures.
+	BreakerClosed BreakerState = iota
+
+	// BreakerOpen rejects every call until the cooldown has elapsed.
+	BreakerOpen
+
+	// BreakerHalfOpen lets a limited number of probe calls through to decide
+	// whether the breaker should close again.
+	BreakerHalfOpen
+)
+
+// String returns a human-readable name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerTicket identifies a call allowed by Breaker.Allow.
+// Pass it to Breaker.Record together with the outcome of the call.
+type BreakerTicket struct {
+	generation uint64
+	probe      uint64
+}
+
+// probeLease is a probe call of a half-open breaker that has not been recorded yet.
+type probeLease struct {
+	id       uint64
+	deadline time.Time
+}
+
+// BreakerOption configures a Breaker during creation.
+type BreakerOption func(*Breaker)
+
+// WithBreakerThreshold sets the number of failures within the window that opens the breaker.
+// Defaults to 5.
+func WithBreakerThreshold(n int) BreakerOption {
+	return func(b *Breaker) {
+		b.threshold = n
+	}
+}
+
+// WithBreakerWindow sets the sliding window in which failures are counted.
+// Defaults to one minute.
+func WithBreakerWindow(d time.Duration) BreakerOption {
+	return func(b *Breaker) {
+		b.window = d
+	}
+}
+
+// WithBreakerCooldown sets how long the breaker stays open before it lets
+// probe calls through. Defaults to 30 seconds.
+func WithBreakerCooldown(d time.Duration) BreakerOption {
+	return func(b *Breaker) {
+		b.cooldown = d
+	}
+}
+
+// WithBreakerHalfOpenProbes sets how many concurrent probe calls are allowed
+// while the breaker is half-open. Defaults to 1.
+// A probe that is not recorded within one cooldown frees its slot for a new probe.
+func WithBreakerHalfOpenProbes(n int) BreakerOption {
+	return func(b *Breaker) {
+		b.probes = n
+	}
+}
+
+// WithBreakerClock replaces the time source of the breaker.
+// This is primarily useful for testing with a fake clock.
+func WithBreakerClock(now func() time.Time) BreakerOption {
+	return func(b *Breaker) {
+		b.now = now
+	}
+}
+
+// WithBreakerTrigger sets the predicate that decides whether an error counts
+// as a failure. Validation and not-found errors never count, regardless of
+// the predicate.
+//
+// Example:
+//
+//	breaker := errorsx.NewBreaker("payment.gateway",
+//		errorsx.WithBreakerTrigger(func(err error) bool {
+//			return errors.Is(err, context.DeadlineExceeded)
+//		}),
+//	)
+func WithBreakerTrigger(trigger func(error) bool) BreakerOption {
+	return func(b *Breaker) {
+		b.trigger = trigger
+	}
+}
+
+// WithBreakerTypes counts only errors whose chain contains one of the given ErrorTypes.
+func WithBreakerTypes(types ...ErrorType) BreakerOption {
+	return WithBreakerTrigger(func(err error) bool {
+		for _, typ := range types {
+			if HasType(err, typ) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// WithBreakerRetryable counts only retryable errors as failures.
+func WithBreakerRetryable() BreakerOption {
+	return WithBreakerTrigger(IsRetryable)
+}
+
+// Breaker is a circuit breaker driven by errorsx error classification.
+//
+// The breaker opens after the configured number of failures occurred within
+// a sliding window. While open, calls are rejected with a retryable error
+// whose ID is CircuitOpenID and which carries a retry-after hint. After the
+// cooldown the breaker becomes half-open and lets probe calls through: a
+// successful probe closes the breaker, a failed probe opens it again.
+// Outcomes of calls allowed before the last state change, such as calls that
+// were still running when the breaker opened, are ignored. A probe holds its
+// slot for at most one cooldown, so a probe that is never recorded does not
+// keep the breaker half-open forever.
+//
+// Validation and not-found errors never trip the breaker, since they describe
+// the request rather than the health of the dependency.
+//
+// Example:
+//
+//	breaker := errorsx.NewBreaker("inventory.api",
+//		errorsx.WithBreakerThreshold(3),
+//		errorsx.WithBreakerRetryable(),
+//	)
+//
+//	err := breaker.Do(func() error {
+//		return client.Reserve(ctx, item)
+//	})
+//
+// Breaker is safe for concurrent use.
+type Breaker struct {
+	name      string
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+	probes    int
+	now       func() time.Time
+	trigger   func(error) bool
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures []time.Time
+	openedAt time.Time
+	leases   []probeLease
+	lastID   uint64
+	// generation changes on every state change, so that Record only counts
+	// calls allowed in the current state, such as the probes of a half-open breaker.
+	generation uint64
+}
+
+// NewBreaker creates a closed Breaker with the given name and options.
+// The name is included in the reason of circuit-open errors.
+func NewBreaker(name string, opts ...BreakerOption) *Breaker {
+	b := &Breaker{
+		name:      name,
+		threshold: defaultBreakerThreshold,
+		window:    defaultBreakerWindow,
+		cooldown:  defaultBreakerCooldown,
+		probes:    defaultBreakerProbes,
+		now:       time.Now,
+		state:     BreakerClosed,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.now())
+	return b.state
+}
+
+// Allow reports whether a call may proceed. It returns a ticket and nil when
+// the call is allowed and a circuit-open *Error otherwise. Every allowed call
+// must be followed by a call to Record with its ticket and outcome.
+func (b *Breaker) Allow() (BreakerTicket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.advance(now)
+	ticket := BreakerTicket{generation: b.generation}
+
+	switch b.state {
+	case BreakerOpen:
+		return BreakerTicket{}, b.openError(b.openedAt.Add(b.cooldown).Sub(now))
+	case BreakerHalfOpen:
+		b.expireLeases(now)
+		if len(b.leases) >= b.probes {
+			return BreakerTicket{}, b.openError(b.leases[0].deadline.Sub(now))
+		}
+		b.lastID++
+		b.leases = append(b.leases, probeLease{id: b.lastID, deadline: now.Add(b.cooldown)})
+		ticket.probe = b.lastID
+	case BreakerClosed:
+	}
+
+	return ticket, nil
+}
+
+// Record reports the outcome of a call previously allowed by Allow.
+// A nil error, or an error that does not count as a failure, is recorded as a success.
+// The outcome is ignored when the breaker changed state since the call was
+// allowed, or when the call was a probe whose lease has expired.
+func (b *Breaker) Record(ticket BreakerTicket, err error) {
+	b.record(ticket, b.isFailure(err))
+}
+
+func (b *Breaker) record(ticket BreakerTicket, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.advance(now)
+	if ticket.generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case BreakerHalfOpen:
+		b.expireLeases(now)
+		if !b.releaseLease(ticket.probe) {
+			return
+		}
+		if failed {
+			b.open(now)
+		} else {
+			b.close()
+		}
+	case BreakerClosed:
+		if !failed {
+			return
+		}
+		b.failures = append(b.failures, now)
+		b.prune(now)
+		if len(b.failures) >= b.threshold {
+			b.open(now)
+		}
+	case BreakerOpen:
+	}
+}
+
+// Do runs fn if the breaker allows it and records its outcome.
+// It returns the circuit-open error without calling fn while the breaker rejects calls.
+// A panic in fn is recorded as a failure and propagated.
+func (b *Breaker) Do(fn func() error) error {
+	ticket, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	panicked := true
+	defer func() {
+		if panicked {
+			b.record(ticket, true)
+		}
+	}()
+	err = fn()
+	panicked = false
+	b.Record(ticket, err)
+
+	return err
+}
+
+// Reset closes the breaker and forgets all recorded failures.
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.close()
+}
+
+func (b *Breaker) isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if HasType(err, TypeValidation) || HasType(err, TypeNotFound) || IsNotFound(err) {
+		return false
+	}
+	if b.trigger != nil {
+		return b.trigger(err)
+	}
+
+	return true
+}
+
+// advance moves an open breaker to half-open once the cooldown has elapsed.
+func (b *Breaker) advance(now time.Time) {
+	if b.state == BreakerOpen && !now.Before(b.openedAt.Add(b.cooldown)) {
+		b.state = BreakerHalfOpen
+		b.leases = nil
+		b.generation++
+	}
+}
+
+// expireLeases drops the probes that were not recorded within one cooldown.
+func (b *Breaker) expireLeases(now time.Time) {
+	i := 0
+	for i < len(b.leases) && !now.Before(b.leases[i].deadline) {
+		i++
+	}
+	b.leases = b.leases[i:]
+}
+
+// releaseLease drops the lease of the probe with the given ID and reports
+// whether it was still held.
+func (b *Breaker) releaseLease(id uint64) bool {
+	for i, lease := range b.leases {
+		if lease.id == id {
+			b.leases = append(b.leases[:i], b.leases[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Breaker) prune(now time.Time) {
+	cutoff := now.Add(-b.window)
+	i := 0
+	for i < len(b.failures) && !b.failures[i].After(cutoff) {
+		i++
+	}
+	b.failures = b.failures[i:]
+}
+
+func (b *Breaker) open(now time.Time) {
+	b.state = BreakerOpen
+	b.openedAt = now
+	b.failures = nil
+	b.leases = nil
+	b.generation++
+}
+
+func (b *Breaker) close() {
+	b.state = BreakerClosed
+	b.failures = nil
+	b.leases = nil
+	b.generation++
+}
+
+func (b *Breaker) openError(retryAfter time.Duration) *Error {
+	return newInternal(CircuitOpenID,
+		WithType(TypeUnavailable),
+		WithRetryAfter(retryAfter),
+	).WithReason("circuit breaker %q is open", b.name)
+}
@@ -0,0 +1,257 @@
+package errorsx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type BreakerSuite struct {
+	suite.Suite
+	clock *fakeClock
+}
+
+func (s *BreakerSuite) SetupTest() {
+	s.clock = &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (s *BreakerSuite) newBreaker(opts ...errorsx.BreakerOption) *errorsx.Breaker {
+	opts = append([]errorsx.BreakerOption{
+		errorsx.WithBreakerThreshold(3),
+		errorsx.WithBreakerWindow(10 * time.Second),
+		errorsx.WithBreakerCooldown(5 * time.Second),
+		errorsx.WithBreakerClock(s.clock.Now),
+	}, opts...)
+	return errorsx.NewBreaker("test.dependency", opts...)
+}
+
+func (s *BreakerSuite) fail(b *errorsx.Breaker, err error) {
+	ticket, allowErr := b.Allow()
+	s.Require().NoError(allowErr)
+	b.Record(ticket, err)
+}
+
+func (s *BreakerSuite) TestOpensAfterThreshold() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+
+	s.fail(b, failure)
+	s.fail(b, failure)
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+
+	s.fail(b, failure)
+	s.Require().Equal(errorsx.BreakerOpen, b.State())
+
+	_, err := b.Allow()
+	s.Require().Error(err)
+	s.Require().True(errors.Is(err, errorsx.ErrCircuitOpen))
+	s.Require().True(errorsx.IsRetryable(err))
+	s.Require().Equal(503, errorsx.HTTPStatus(err))
+
+	retryAfter, ok := errorsx.RetryAfter(err)
+	s.Require().True(ok)
+	s.Require().Equal(5*time.Second, retryAfter)
+}
+
+func (s *BreakerSuite) TestSlidingWindowDropsOldFailures() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+
+	s.fail(b, failure)
+	s.fail(b, failure)
+	s.clock.Advance(11 * time.Second)
+	s.fail(b, failure)
+
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestHalfOpenAfterCooldown() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+	for i := 0; i < 3; i++ {
+		s.fail(b, failure)
+	}
+
+	s.clock.Advance(2 * time.Second)
+	_, err := b.Allow()
+	retryAfter, _ := errorsx.RetryAfter(err)
+	s.Require().Equal(3*time.Second, retryAfter)
+
+	s.clock.Advance(3 * time.Second)
+	s.Require().Equal(errorsx.BreakerHalfOpen, b.State())
+
+	// Only one probe is allowed at a time
+	probe, err := b.Allow()
+	s.Require().NoError(err)
+	_, err = b.Allow()
+	s.Require().Error(err)
+
+	b.Record(probe, nil)
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestFailedProbeReopens() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+	for i := 0; i < 3; i++ {
+		s.fail(b, failure)
+	}
+
+	s.clock.Advance(5 * time.Second)
+	s.fail(b, failure)
+	s.Require().Equal(errorsx.BreakerOpen, b.State())
+}
+
+func (s *BreakerSuite) TestStaleOutcomesAreIgnored() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+	stale, err := b.Allow()
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		s.fail(b, failure)
+	}
+
+	s.clock.Advance(5 * time.Second)
+	probe, err := b.Allow()
+	s.Require().NoError(err)
+
+	// A call allowed before the breaker opened does not decide the probe
+	b.Record(stale, nil)
+	s.Require().Equal(errorsx.BreakerHalfOpen, b.State())
+	b.Record(stale, failure)
+	s.Require().Equal(errorsx.BreakerHalfOpen, b.State())
+	_, err = b.Allow()
+	s.Require().Error(err)
+
+	b.Record(probe, nil)
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+
+	// Nor does a probe recorded after the breaker closed
+	b.Record(probe, failure)
+	b.Record(probe, failure)
+	b.Record(probe, failure)
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestPanickingProbeReopens() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+	for i := 0; i < 3; i++ {
+		s.fail(b, failure)
+	}
+
+	s.clock.Advance(5 * time.Second)
+	s.Require().PanicsWithValue("boom", func() {
+		_ = b.Do(func() error { panic("boom") })
+	})
+	s.Require().Equal(errorsx.BreakerOpen, b.State())
+
+	s.clock.Advance(5 * time.Second)
+	s.Require().NoError(b.Do(func() error { return nil }))
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestUnrecordedProbeLeaseExpires() {
+	b := s.newBreaker()
+	failure := errorsx.New("db.connection_failed")
+	for i := 0; i < 3; i++ {
+		s.fail(b, failure)
+	}
+
+	s.clock.Advance(5 * time.Second)
+	abandoned, err := b.Allow()
+	s.Require().NoError(err)
+
+	s.clock.Advance(2 * time.Second)
+	_, err = b.Allow()
+	retryAfter, _ := errorsx.RetryAfter(err)
+	s.Require().Equal(3*time.Second, retryAfter)
+
+	s.clock.Advance(3 * time.Second)
+	probe, err := b.Allow()
+	s.Require().NoError(err)
+
+	// The abandoned probe lost its slot and no longer decides the state
+	b.Record(abandoned, nil)
+	s.Require().Equal(errorsx.BreakerHalfOpen, b.State())
+
+	b.Record(probe, nil)
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestValidationAndNotFoundDoNotTrip() {
+	b := s.newBreaker()
+
+	for i := 0; i < 5; i++ {
+		s.fail(b, errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation)))
+		s.fail(b, errorsx.NewNotFound("user.not_found"))
+		s.fail(b, errorsx.NewValidationError("form.invalid"))
+	}
+
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+}
+
+func (s *BreakerSuite) TestTriggerByType() {
+	const typeUpstream errorsx.ErrorType = "test.upstream"
+	b := s.newBreaker(errorsx.WithBreakerTypes(typeUpstream))
+
+	for i := 0; i < 5; i++ {
+		s.fail(b, errorsx.New("other.failure"))
+	}
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+
+	for i := 0; i < 3; i++ {
+		s.fail(b, errorsx.New("upstream.failure", errorsx.WithType(typeUpstream)))
+	}
+	s.Require().Equal(errorsx.BreakerOpen, b.State())
+}
+
+func (s *BreakerSuite) TestTriggerByRetryable() {
+	b := s.newBreaker(errorsx.WithBreakerRetryable())
+
+	for i := 0; i < 5; i++ {
+		s.fail(b, errors.New("permanent failure"))
+	}
+	s.Require().Equal(errorsx.BreakerClosed, b.State())
+
+	for i := 0; i < 3; i++ {
+		s.fail(b, errorsx.NewRetryable("network.timeout"))
+	}
+	s.Require().Equal(errorsx.BreakerOpen, b.State())
+}
+
+func (s *BreakerSuite) TestDo() {
+	b := s.newBreaker(errorsx.WithBreakerThreshold(1))
+	calls := 0
+	failing := func() error {
+		calls++
+		return errorsx.New("db.connection_failed")
+	}
+
+	s.Require().Error(b.Do(failing))
+	err := b.Do(failing)
+	s.Require().True(errors.Is(err, errorsx.ErrCircuitOpen))
+	s.Require().Equal(1, calls)
+
+	b.Reset()
+	s.Require().NoError(b.Do(func() error { return nil }))
+}
+
+func TestBreakerSuite(t *testing.T) {
+	suite.Run(t, new(BreakerSuite))
+}
@@ -0,0 +1,162 @@
+package errorsx
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// IDs assigned by Classify to recognized standard library errors.
+const (
+	StdlibCanceledID          = "errorsx.stdlib.canceled"
+	StdlibDeadlineExceededID  = "errorsx.stdlib.deadline_exceeded"
+	StdlibNotExistID          = "errorsx.stdlib.not_exist"
+	StdlibPermissionID        = "errorsx.stdlib.permission"
+	StdlibNetTimeoutID        = "errorsx.stdlib.net_timeout"
+	StdlibConnectionRefusedID = "errorsx.stdlib.connection_refused"
+	StdlibConnectionResetID   = "errorsx.stdlib.connection_reset"
+	StdlibUnexpectedEOFID     = "errorsx.stdlib.unexpected_eof"
+	StdlibNoRowsID            = "errorsx.stdlib.no_rows"
+	StdlibTxDoneID            = "errorsx.stdlib.tx_done"
+	StdlibJSONSyntaxID        = "errorsx.stdlib.json_syntax"
+	StdlibJSONTypeID          = "errorsx.stdlib.json_type"
+	StdlibNumberID            = "errorsx.stdlib.number"
+
+	// UnclassifiedID is the ID assigned by Classify to errors it does not recognize.
+	UnclassifiedID = "errorsx.unclassified"
+)
+
+// stdlibRule recognizes a standard library error.
+type stdlibRule struct {
+	id      string
+	typ     ErrorType
+	matches func(error) bool
+}
+
+// stdlibRules is the classification table of StdlibInferer and Classify.
+// Rules are tried in order; context errors come first because they also
+// report themselves as net.Error timeouts.
+//
+//nolint:gochecknoglobals
+var stdlibRules = []stdlibRule{
+	{StdlibCanceledID, TypeCanceled, isTarget(context.Canceled)},
+	{StdlibDeadlineExceededID, TypeTimeout, isTarget(context.DeadlineExceeded)},
+	{StdlibNotExistID, TypeNotFound, isTarget(fs.ErrNotExist)},
+	{StdlibPermissionID, TypePermissionDenied, isTarget(fs.ErrPermission)},
+	{StdlibNetTimeoutID, TypeTimeout, isNetTimeout},
+	{StdlibConnectionRefusedID, TypeUnavailable, isTarget(syscall.ECONNREFUSED)},
+	{StdlibConnectionResetID, TypeUnavailable, isTarget(syscall.ECONNRESET)},
+	{StdlibUnexpectedEOFID, TypeUnavailable, isTarget(io.ErrUnexpectedEOF)},
+	{StdlibNoRowsID, TypeNotFound, isTarget(sql.ErrNoRows)},
+	{StdlibTxDoneID, TypeInternal, isTarget(sql.ErrTxDone)},
+	{StdlibJSONSyntaxID, TypeValidation, isAs[*json.SyntaxError]},
+	{StdlibJSONTypeID, TypeValidation, isAs[*json.UnmarshalTypeError]},
+	{StdlibNumberID, TypeValidation, isAs[*strconv.NumError]},
+}
+
+func isTarget(target error) func(error) bool {
+	return func(err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
+func isAs[T error](err error) bool {
+	var target T
+	return errors.As(err, &target)
+}
+
+func isNetTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func matchStdlibRule(err error) (stdlibRule, bool) {
+	for _, rule := range stdlibRules {
+		if rule.matches(err) {
+			return rule, true
+		}
+	}
+	return stdlibRule{}, false
+}
+
+// StdlibInferer creates an ErrorTypeInferer that recognizes common standard
+// library errors anywhere in the chain of an error:
+//
+//   - context.Canceled: TypeCanceled
+//   - context.DeadlineExceeded and net.Error timeouts: TypeTimeout
+//   - fs.ErrNotExist and sql.ErrNoRows: TypeNotFound
+//   - fs.ErrPermission: TypePermissionDenied
+//   - syscall.ECONNREFUSED, syscall.ECONNRESET and io.ErrUnexpectedEOF: TypeUnavailable
+//   - sql.ErrTxDone: TypeInternal
+//   - *json.SyntaxError, *json.UnmarshalTypeError and *strconv.NumError: TypeValidation
+//
+// HTTP status, retryability and not-found follow from the metadata of these types.
+//
+// Example:
+//
+//	errorsx.SetGlobalTypeInferer(errorsx.StdlibInferer())
+//
+//	err := errorsx.New("user.load_failed").WithCause(sql.ErrNoRows)
+//	err.Type()       // errorsx.TypeNotFound
+//	err.IsNotFound() // true
+func StdlibInferer() ErrorTypeInferer {
+	return func(e *Error) ErrorType {
+		if rule, ok := matchStdlibRule(e); ok {
+			e.recordRule("%s", rule.id)
+			return rule.typ
+		}
+		return TypeUnknown
+	}
+}
+
+// Classify converts an error from the standard library or third-party code
+// into an *Error, using the same rules as StdlibInferer.
+//
+// A recognized error is wrapped in a new *Error with one of the
+// errorsx.stdlib.* IDs and the matching type; other errors are wrapped with
+// UnclassifiedID. The wrapper keeps the original message and captures the
+// stack trace of the caller.
+//
+// If err is or wraps an *Error, the first *Error in its chain is returned
+// as-is, so its ID, type and HTTP status are kept, except that an untyped
+// *Error gets the type of the first recognized error in its chain.
+// Returns nil if err is nil.
+//
+// Example:
+//
+//	row := db.QueryRowContext(ctx, query, id)
+//	if err := row.Scan(&user.Name); err != nil {
+//		return errorsx.Classify(err) // IsNotFound for sql.ErrNoRows
+//	}
+func Classify(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		if rule, ok := matchStdlibRule(e); ok && e.Type() == TypeUnknown {
+			return e.WithType(rule.typ)
+		}
+		return e
+	}
+	rule, ok := matchStdlibRule(err)
+	if !ok {
+		rule = stdlibRule{id: UnclassifiedID, typ: TypeUnknown}
+	}
+
+	e = newInternal(rule.id, WithType(rule.typ)).WithReason("%s", err.Error())
+	e.cause = err
+	if st, ok := e.captureStack(0); ok {
+		e.stacks = []StackTrace{st}
+	}
+	e.isStacked = true
+
+	return e
+}
@@ -0,0 +1,141 @@
+package errorsx_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClassifySuite struct {
+	suite.Suite
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ net.Error = timeoutError{}
+
+func (s *ClassifySuite) TestStdlibErrors() {
+	_, numErr := strconv.Atoi("abc")
+	jsonSyntaxErr := json.Unmarshal([]byte("{"), &map[string]any{})
+	var n int
+	jsonTypeErr := json.Unmarshal([]byte(`"text"`), &n)
+	_, notExistErr := os.Open("/nonexistent/errorsx")
+
+	tests := []struct {
+		name      string
+		err       error
+		id        string
+		typ       errorsx.ErrorType
+		status    int
+		retryable bool
+		notFound  bool
+	}{
+		{"context canceled", context.Canceled, errorsx.StdlibCanceledID, errorsx.TypeCanceled, 499, false, false},
+		{"deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), errorsx.StdlibDeadlineExceededID, errorsx.TypeTimeout, 504, true, false},
+		{"file not found", notExistErr, errorsx.StdlibNotExistID, errorsx.TypeNotFound, 404, false, true},
+		{"permission", &fs.PathError{Op: "open", Path: "/etc/shadow", Err: fs.ErrPermission}, errorsx.StdlibPermissionID, errorsx.TypePermissionDenied, 403, false, false},
+		{"net timeout", &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}, errorsx.StdlibNetTimeoutID, errorsx.TypeTimeout, 504, true, false},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, errorsx.StdlibConnectionRefusedID, errorsx.TypeUnavailable, 503, true, false},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), errorsx.StdlibConnectionResetID, errorsx.TypeUnavailable, 503, true, false},
+		{"unexpected EOF", io.ErrUnexpectedEOF, errorsx.StdlibUnexpectedEOFID, errorsx.TypeUnavailable, 503, true, false},
+		{"no rows", sql.ErrNoRows, errorsx.StdlibNoRowsID, errorsx.TypeNotFound, 404, false, true},
+		{"tx done", sql.ErrTxDone, errorsx.StdlibTxDoneID, errorsx.TypeInternal, 500, false, false},
+		{"json syntax", jsonSyntaxErr, errorsx.StdlibJSONSyntaxID, errorsx.TypeValidation, 400, false, false},
+		{"json type", jsonTypeErr, errorsx.StdlibJSONTypeID, errorsx.TypeValidation, 400, false, false},
+		{"number", numErr, errorsx.StdlibNumberID, errorsx.TypeValidation, 400, false, false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Require().Error(tt.err)
+
+			err := errorsx.Classify(tt.err)
+			s.Equal(tt.id, err.ID())
+			s.Equal(tt.typ, err.Type())
+			s.Equal(tt.status, err.HTTPStatus())
+			s.Equal(tt.retryable, err.IsRetryable())
+			s.Equal(tt.notFound, err.IsNotFound())
+			s.Equal(tt.err.Error(), err.Error())
+			s.ErrorIs(err, tt.err)
+		})
+	}
+}
+
+func (s *ClassifySuite) TestUnclassified() {
+	cause := errors.New("third-party failure")
+	err := errorsx.Classify(cause)
+
+	s.Equal(errorsx.UnclassifiedID, err.ID())
+	s.Equal(errorsx.TypeUnknown, err.Type())
+	s.Equal("third-party failure", err.Error())
+	s.ErrorIs(err, cause)
+}
+
+func (s *ClassifySuite) TestNil() {
+	s.Nil(errorsx.Classify(nil))
+}
+
+func (s *ClassifySuite) TestCapturesCallerStack() {
+	err := errorsx.Classify(io.ErrUnexpectedEOF)
+
+	s.Require().Len(err.Stacks(), 1)
+	trace := errorsx.FullStackTrace(err)
+	s.Contains(trace, "TestCapturesCallerStack")
+	s.NotContains(trace, "errorsx.Classify")
+}
+
+func (s *ClassifySuite) TestWrappingErrors() {
+	untyped := errorsx.New("user.load_failed").WithCause(sql.ErrNoRows)
+	classified := errorsx.Classify(untyped)
+	s.Equal("user.load_failed", classified.ID())
+	s.Equal(errorsx.TypeNotFound, classified.Type())
+	s.True(classified.IsNotFound())
+
+	typed := errorsx.New("user.load_failed", errorsx.WithType(errorsx.TypeInternal)).WithCause(sql.ErrNoRows)
+	s.Same(typed, errorsx.Classify(typed))
+
+	unrecognized := errorsx.New("user.load_failed")
+	s.Same(unrecognized, errorsx.Classify(unrecognized))
+
+	notFound := errorsx.NewNotFound("user.not_found")
+	wrapped := errorsx.Classify(fmt.Errorf("load: %w", notFound))
+	s.Same(notFound, wrapped)
+	s.Equal(404, errorsx.HTTPStatus(wrapped))
+	s.True(errorsx.IsNotFound(wrapped))
+
+	retyped := errorsx.Classify(fmt.Errorf("load: %w", untyped))
+	s.Equal("user.load_failed", retyped.ID())
+	s.Equal(errorsx.TypeNotFound, retyped.Type())
+}
+
+func (s *ClassifySuite) TestStdlibInferer() {
+	errorsx.SetGlobalTypeInferer(errorsx.StdlibInferer())
+	defer errorsx.ClearGlobalTypeInferer()
+
+	err := errorsx.New("db.query_failed").WithCause(fmt.Errorf("query: %w", context.DeadlineExceeded))
+	s.Equal(errorsx.TypeTimeout, err.Type())
+	s.True(err.IsRetryable())
+	s.Equal(504, errorsx.HTTPStatus(err))
+
+	s.Equal(errorsx.TypeUnknown, errorsx.New("other.failed").Type())
+}
+
+func TestClassifySuite(t *testing.T) {
+	suite.Run(t, new(ClassifySuite))
+}
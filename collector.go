@@ -0,0 +1,161 @@
+package errorsx
+
+import (
+	"errors"
+	"sync"
+)
+
+// MoreErrorsID is the ID of the summary error appended by a Collector when
+// errors were dropped because of its limit.
+const MoreErrorsID = "errorsx.more_errors"
+
+// DedupMode determines how a Collector detects duplicate errors.
+type DedupMode int
+
+const (
+	// DedupNone keeps every error.
+	DedupNone DedupMode = iota
+
+	// DedupByID drops errors whose ID was already collected.
+	// Errors that are not errorsx.Error are compared by their message.
+	DedupByID
+
+	// DedupByIDAndMessage drops errors whose ID and message were both already collected.
+	DedupByIDAndMessage
+)
+
+// CollectorOption configures a Collector during creation.
+type CollectorOption func(*Collector)
+
+// WithMaxErrors limits the number of errors kept by the collector.
+// Further errors are counted and summarized as "and N more error(s)".
+// A value of 0 or less means no limit.
+func WithMaxErrors(n int) CollectorOption {
+	return func(c *Collector) {
+		c.max = n
+	}
+}
+
+// WithDedup sets how the collector detects duplicate errors.
+func WithDedup(mode DedupMode) CollectorOption {
+	return func(c *Collector) {
+		c.dedup = mode
+	}
+}
+
+// Collector accumulates errors from multiple goroutines.
+// It is safe for concurrent use, and its zero value is an unlimited collector
+// without deduplication.
+//
+// Example:
+//
+//	c := errorsx.NewCollector(errorsx.WithMaxErrors(10), errorsx.WithDedup(errorsx.DedupByID))
+//	for _, row := range rows {
+//		row := row
+//		wg.Add(1)
+//		go func() {
+//			defer wg.Done()
+//			c.Add(validateRow(row))
+//		}()
+//	}
+//	wg.Wait()
+//	return c.Err()
+type Collector struct {
+	max   int
+	dedup DedupMode
+
+	mu      sync.Mutex
+	errs    []error
+	seen    map[string]struct{}
+	dropped int
+}
+
+// NewCollector creates a new Collector with the given options.
+func NewCollector(opts ...CollectorOption) *Collector {
+	c := &Collector{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Add records err. Nil errors and duplicates are ignored.
+// When the limit is reached the error is only counted.
+func (c *Collector) Add(err error) {
+	if err == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.dedupKey(err); ok {
+		if _, dup := c.seen[key]; dup {
+			return
+		}
+		if c.seen == nil {
+			c.seen = map[string]struct{}{}
+		}
+		c.seen[key] = struct{}{}
+	}
+
+	if c.max > 0 && len(c.errs) >= c.max {
+		c.dropped++
+		return
+	}
+	c.errs = append(c.errs, err)
+}
+
+// Len returns the number of recorded errors, including those dropped because of the limit.
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.errs) + c.dropped
+}
+
+// Errors returns a copy of the kept errors.
+func (c *Collector) Errors() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]error(nil), c.errs...)
+}
+
+// Err returns the Join of the collected errors, or nil if none were added.
+// If errors were dropped because of the limit, a summary error with the ID
+// MoreErrorsID is appended.
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	errs := append([]error(nil), c.errs...)
+	if c.dropped > 0 {
+		errs = append(errs, newInternal(MoreErrorsID).WithReason("and %d more error(s)", c.dropped))
+	}
+
+	return Join(errs...)
+}
+
+func (c *Collector) dedupKey(err error) (string, bool) {
+	switch c.dedup {
+	case DedupByID:
+		return errorID(err), true
+	case DedupByIDAndMessage:
+		return errorID(err) + "\x00" + err.Error(), true
+	case DedupNone:
+	}
+
+	return "", false
+}
+
+// errorID returns the ID of the first errorsx.Error in the chain,
+// or the error message for other errors.
+func errorID(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.id
+	}
+	return err.Error()
+}
@@ -0,0 +1,94 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type CollectorSuite struct {
+	suite.Suite
+}
+
+func (s *CollectorSuite) TestEmptyCollector() {
+	var c errorsx.Collector
+	c.Add(nil)
+	s.Require().NoError(c.Err())
+	s.Require().Equal(0, c.Len())
+}
+
+func (s *CollectorSuite) TestCollectsErrors() {
+	c := errorsx.NewCollector()
+	err1 := errorsx.New("user.invalid")
+	err2 := errors.New("plain error")
+	c.Add(err1)
+	c.Add(err2)
+
+	err := c.Err()
+	s.Require().EqualError(err, "user.invalid; plain error")
+	s.Require().True(errors.Is(err, err1))
+	s.Require().True(errors.Is(err, err2))
+	s.Require().Equal([]error{err1, err2}, c.Errors())
+}
+
+func (s *CollectorSuite) TestMaxErrors() {
+	c := errorsx.NewCollector(errorsx.WithMaxErrors(2))
+	for i := 0; i < 5; i++ {
+		c.Add(errorsx.New(fmt.Sprintf("row.%d.invalid", i)))
+	}
+
+	s.Require().Equal(5, c.Len())
+	s.Require().Len(c.Errors(), 2)
+	s.Require().EqualError(c.Err(), "row.0.invalid; row.1.invalid; and 3 more error(s)")
+	s.Require().True(errors.Is(c.Err(), errorsx.New(errorsx.MoreErrorsID)))
+}
+
+func (s *CollectorSuite) TestDedupByID() {
+	c := errorsx.NewCollector(errorsx.WithDedup(errorsx.DedupByID))
+	c.Add(errorsx.New("user.invalid").WithReason("first"))
+	c.Add(errorsx.New("user.invalid").WithReason("second"))
+	c.Add(fmt.Errorf("wrapped: %w", errorsx.New("user.invalid")))
+	c.Add(errors.New("plain"))
+	c.Add(errors.New("plain"))
+
+	s.Require().EqualError(c.Err(), "first; plain")
+}
+
+func (s *CollectorSuite) TestDedupByIDAndMessage() {
+	c := errorsx.NewCollector(errorsx.WithDedup(errorsx.DedupByIDAndMessage))
+	c.Add(errorsx.New("user.invalid").WithReason("first"))
+	c.Add(errorsx.New("user.invalid").WithReason("first"))
+	c.Add(errorsx.New("user.invalid").WithReason("second"))
+
+	s.Require().EqualError(c.Err(), "first; second")
+}
+
+func (s *CollectorSuite) TestConcurrentAdd() {
+	c := errorsx.NewCollector(errorsx.WithMaxErrors(10))
+	verr := errorsx.NewValidationError("form.invalid")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Add(errorsx.New(fmt.Sprintf("item.%d", i)))
+			verr.AddFieldError(fmt.Sprintf("field%d", i), "required", nil)
+			_ = verr.Error()
+		}()
+	}
+	wg.Wait()
+
+	s.Require().Equal(50, c.Len())
+	s.Require().Len(c.Errors(), 10)
+	s.Require().Len(verr.FieldErrors, 50)
+}
+
+func TestCollectorSuite(t *testing.T) {
+	suite.Run(t, new(CollectorSuite))
+}
@@ -35,6 +35,7 @@ package errorsx
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Error represents a structured, chainable error with stack trace and attributes.
@@ -57,7 +58,14 @@ type Error struct {
 	stackTraceCleaner StackTraceCleaner
 	isNotFound        bool
 	isRetryable       bool
+	retryAfter        time.Duration
 	isStacked         bool
+	typeCache         *typeCache
+	explain           *explainRecorder
+	factory           *Factory
+	prefixMatch       bool
+	maxStackFrames    int
+	stackCapture      StackCaptureMode
 }
 
 // New creates a new Error with the given id and options.
@@ -73,22 +81,12 @@ type Error struct {
 //	)
 //
 // The id should follow a hierarchical naming convention (e.g., "domain.operation.reason")
-// to facilitate error categorization and handling.
+// to facilitate error categorization and handling. Namespace creates a factory
+// that prefixes the domain by construction.
+//
+// New uses the configuration of DefaultFactory.
 func New(id string, opts ...Option) *Error {
-	e := &Error{
-		id:          id,
-		msg:         id,
-		errType:     TypeUnknown,
-		stacks:      nil,
-		isNotFound:  false,
-		isRetryable: false,
-		isStacked:   false,
-	}
-	for _, opt := range opts {
-		opt(e)
-	}
-
-	return e
+	return DefaultFactory().New(id, opts...)
 }
 
 // ID returns the unique identifier of the error.
@@ -109,9 +107,18 @@ func (e *Error) ID() string {
 // Note: This creates a shallow copy of the error, preserving the original
 // error's stack traces and other attributes.
 func (e *Error) WithReason(reason string, params ...any) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.msg = fmt.Sprintf(reason, params...)
 
+	return clone
+}
+
+// clone returns a shallow copy of the error with an empty type cache, since
+// the inferred type may depend on the attributes the caller is about to change.
+func (e *Error) clone() *Error {
+	clone := *e
+	clone.typeCache = &typeCache{}
+
 	return &clone
 }
 
@@ -132,7 +139,8 @@ func (e *Error) Unwrap() error {
 }
 
 // Is implements custom error comparison for errors.Is().
-// Two errorsx.Error instances are considered equal if they have the same ID.
+// Two errorsx.Error instances are considered equal if they have the same ID,
+// or IDs that resolve to the same canonical ID (see RegisterIDAlias).
 // For non-errorsx errors, it delegates to the underlying error's Is method
 // or compares the cause error.
 //
@@ -142,6 +150,9 @@ func (e *Error) Unwrap() error {
 //	if errors.Is(err, errorsx.New("user.not_found")) {
 //		// Handle user not found error
 //	}
+//
+// If target was created with WithPrefixMatch, errors whose ID is target's ID
+// or one of its descendants match as well.
 func (e *Error) Is(target error) bool {
 	if e == nil {
 		return false
@@ -153,8 +164,14 @@ func (e *Error) Is(target error) bool {
 	if !ok {
 		return errors.Is(e.cause, target)
 	}
+	if e.id == t.id {
+		return true
+	}
+	if t.prefixMatch {
+		return ID(CanonicalID(e.id)).HasPrefix(ID(CanonicalID(t.id)))
+	}
 
-	return e.id == t.id
+	return CanonicalID(e.id) == CanonicalID(t.id)
 }
 
 // WithMessage returns a copy of the error with the given message data.
@@ -174,10 +191,10 @@ func (e *Error) Is(target error) bool {
 //
 // The message data can be extracted using the Message() or MessageOr() functions.
 func (e *Error) WithMessage(data any) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.messageData = data
 
-	return &clone
+	return clone
 }
 
 // ReplaceMessage replaces the message data of any error.
@@ -199,7 +216,7 @@ func ReplaceMessage(err error, data any) error {
 		return xerr.WithMessage(data)
 	}
 
-	return New("unknown.error").
+	return newInternal("unknown.error").
 		WithMessage(data).
 		WithCause(err)
 }
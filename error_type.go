@@ -1,12 +1,13 @@
 package errorsx
 
 import (
-	"errors"
+	"maps"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
-	"sync"
+	"sync/atomic"
 )
 
 // ErrorType represents a string-based error category for classification and filtering.
@@ -22,6 +23,9 @@ import (
 //	if errorsx.HasType(err, TypeAuthentication) {
 //		// Handle authentication errors
 //	}
+//
+// Error types can be arranged in a hierarchy with RegisterType, so that
+// checks for a broad category also match its more specific child types.
 type ErrorType string
 
 // ErrorTypeInferer is a function that dynamically determines the ErrorType
@@ -63,12 +67,10 @@ const (
 	TypeNotFound ErrorType = "errorsx.not_found"
 )
 
-var (
-	// globalInferer is a single global ErrorTypeInferer that is
-	// applied to errors when no specific inferer is set on the error instance.
-	globalInferer ErrorTypeInferer //nolint:gochecknoglobals
-	infererMutex  sync.RWMutex     //nolint:gochecknoglobals
-)
+// infererGeneration is incremented whenever the registered inferers or a
+// registration they may depend on change, invalidating the types cached by
+// every error.
+var infererGeneration atomic.Uint64 //nolint:gochecknoglobals
 
 // SetGlobalTypeInferer sets a global ErrorTypeInferer that will be
 // consulted when determining error types for errors without instance-specific inferers.
@@ -83,25 +85,46 @@ var (
 //		"*sql*":      TypeDatabase,
 //		"*auth*":     TypeAuthentication,
 //	}))
+//
+// SetGlobalTypeInferer registers the inferer under the name GlobalInfererName
+// with priority 0, replacing the previous global inferer. Inferers registered
+// with RegisterTypeInferer under other names are kept. A nil inferer removes
+// the global inferer.
 func SetGlobalTypeInferer(inferer ErrorTypeInferer) {
-	infererMutex.Lock()
-	defer infererMutex.Unlock()
-	globalInferer = inferer
+	if inferer == nil {
+		ClearGlobalTypeInferer()
+		return
+	}
+	RegisterTypeInferer(GlobalInfererName, 0, inferer)
 }
 
-// ClearGlobalTypeInferer removes the registered global type inferer.
+// ClearGlobalTypeInferer removes the inferer set by SetGlobalTypeInferer.
 // This is primarily useful for testing.
 func ClearGlobalTypeInferer() {
-	infererMutex.Lock()
-	defer infererMutex.Unlock()
-	globalInferer = nil
+	unregisterTypeInferer(GlobalInfererName, 0)
+}
+
+// typeCache memoizes the inferred type and the rule overrides of an Error.
+// It is shared by pointer so that copying an Error never copies the atomic value.
+type typeCache struct {
+	resolved atomic.Pointer[resolvedType]
+	override atomic.Pointer[resolvedOverride]
+}
+
+// resolvedType is an inferred type together with the generation of the
+// registered inferers it was computed with.
+type resolvedType struct {
+	typ        ErrorType
+	generation uint64
 }
 
 // IDPatternInferer creates a reusable ErrorTypeInferer that matches error IDs
 // against glob-style patterns. This enables easy pattern-based error classification.
 //
-// Patterns support '*' wildcards and are case-sensitive. The first matching
-// pattern determines the ErrorType.
+// Patterns use filepath.Match syntax and are case-sensitive. Patterns are
+// tried from the longest to the shortest, and lexically among patterns of the
+// same length, so the result is deterministic when several patterns match.
+// Use RulesInferer for explicit ordering, regexps and segment globs.
 //
 // Example:
 //
@@ -113,11 +136,14 @@ func ClearGlobalTypeInferer() {
 //
 // This inferer can be reused across multiple errors and is thread-safe.
 func IDPatternInferer(patterns map[string]ErrorType) ErrorTypeInferer {
+	patterns = maps.Clone(patterns)
+	keys := longestFirst(patterns)
 	return func(e *Error) ErrorType {
 		id := e.ID()
-		for pattern, errType := range patterns {
+		for _, pattern := range keys {
 			if matched, _ := filepath.Match(pattern, id); matched {
-				return errType
+				e.recordRule("%q", pattern)
+				return patterns[pattern]
 			}
 		}
 		return TypeUnknown
@@ -294,7 +320,8 @@ func StackTraceInferer(fn StackTraceInfererFunc) ErrorTypeInferer {
 
 // IDContainsInferer creates a reusable ErrorTypeInferer that checks if the error ID
 // contains specific substrings. This is a simpler alternative to IDPatternInferer
-// for basic substring matching.
+// for basic substring matching. Like IDPatternInferer, longer substrings are
+// tried first.
 //
 // Example:
 //
@@ -304,17 +331,36 @@ func StackTraceInferer(fn StackTraceInfererFunc) ErrorTypeInferer {
 //		"validation": TypeValidation,
 //	})
 func IDContainsInferer(substrings map[string]ErrorType) ErrorTypeInferer {
+	substrings = maps.Clone(substrings)
+	keys := longestFirst(substrings)
 	return func(e *Error) ErrorType {
 		id := e.ID()
-		for substring, errType := range substrings {
+		for _, substring := range keys {
 			if strings.Contains(id, substring) {
-				return errType
+				e.recordRule("contains %q", substring)
+				return substrings[substring]
 			}
 		}
 		return TypeUnknown
 	}
 }
 
+// longestFirst returns the keys of m sorted from the longest to the shortest,
+// and lexically among keys of the same length.
+func longestFirst(m map[string]ErrorType) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
 // WithType returns a copy of the error with the specified ErrorType.
 // This method allows changing the error type while preserving all other attributes.
 //
@@ -325,11 +371,11 @@ func IDContainsInferer(substrings map[string]ErrorType) ErrorTypeInferer {
 //
 // Note: Setting an explicit type will clear any inferer, and vice versa.
 func (e *Error) WithType(typ ErrorType) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.errType = typ
 	clone.typeInferer = nil // Clear inferer when explicit type is set
 
-	return &clone
+	return clone
 }
 
 // WithTypeInferer returns a copy of the error with the specified ErrorTypeInferer.
@@ -352,66 +398,116 @@ func (e *Error) WithType(typ ErrorType) *Error {
 //
 // Note: Setting an inferer will clear any explicit type, and vice versa.
 func (e *Error) WithTypeInferer(inferer ErrorTypeInferer) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.typeInferer = inferer
 	clone.errType = TypeUnknown // Reset explicit type when inferer is set
 
-	return &clone
+	return clone
 }
 
 // Type returns the ErrorType of the error.
 // Priority order:
-// 1. Explicit type (if set) - highest priority
+// 0. Type override of the RuleSet installed with SetRuleSet (if any)
+// 1. Explicit type (if set)
 // 2. Instance-specific inferer (if set)
-// 3. Global inferer (if set)
-// 4. TypeUnknown (default).
+// 3. Inferers of the Factory that created the error (see Config.TypeInferers)
+// 4. Registered inferers, including the global inferer (see RegisterTypeInferer)
+// 5. TypeUnknown (default).
+//
+// Inferred types are cached per error instance until the registered inferers
+// change, so inferers are expected to be deterministic.
 func (e *Error) Type() ErrorType {
-	// 1. Use explicit type if set (and not TypeUnknown) - highest priority
+	// 0. Use the override of the installed rule set - highest priority
+	if typ := e.override().Type; typ != "" {
+		return typ
+	}
+
+	// 1. Use explicit type if set (and not TypeUnknown)
 	if e.errType != TypeUnknown {
 		return e.errType
 	}
 
+	generation := infererGeneration.Load()
+	if e.typeCache != nil {
+		if r := e.typeCache.resolved.Load(); r != nil && r.generation == generation {
+			return r.typ
+		}
+	}
+
+	typ, panicked := e.inferType()
+	// A panicking inferer may succeed next time, so its fallback is not cached.
+	if e.typeCache != nil && !panicked {
+		e.typeCache.resolved.Store(&resolvedType{typ: typ, generation: generation})
+	}
+
+	return typ
+}
+
+// inferType runs the instance-specific inferer, the factory inferers and then
+// the registered inferers. It also reports whether any inferer panicked.
+func (e *Error) inferType() (ErrorType, bool) {
+	panicked := false
+	infer := func(inferer ErrorTypeInferer) ErrorType {
+		typ, p := safeInfer(inferer, e)
+		panicked = panicked || p
+		return typ
+	}
+
 	// 2. Use instance-specific inferer if set
 	if e.typeInferer != nil {
-		if typ := e.typeInferer(e); typ != TypeUnknown {
-			return typ
+		if typ := infer(e.typeInferer); typ != TypeUnknown {
+			return typ, panicked
 		}
 	}
 
-	// 3. Try global inferer if no result yet
-	infererMutex.RLock()
-	inferer := globalInferer
-	infererMutex.RUnlock()
+	// 3. Try the inferers of the factory
+	for _, inferer := range e.config().TypeInferers {
+		if typ := infer(inferer); typ != TypeUnknown {
+			return typ, panicked
+		}
+	}
 
-	if inferer != nil {
-		if typ := inferer(e); typ != TypeUnknown {
-			return typ
+	// 4. Try registered inferers in priority order if no result yet
+	for _, r := range loadInferers() {
+		if typ := infer(r.Inferer); typ != TypeUnknown {
+			return typ, panicked
 		}
 	}
 
 	// Default to unknown
-	return TypeUnknown
+	return TypeUnknown, panicked
 }
 
 // Type extracts the ErrorType from a generic error.
+// If the error is a MultiError, returns the type shared by its children.
 // If the error is not an errorsx.Error, returns TypeUnknown.
 //
 // This function enables type checking for any error, including
 // wrapped errors and errors from external libraries. It will
 // use dynamic type inference if configured on the error.
 func Type(err error) ErrorType {
-	if e, ok := err.(*Error); ok {
+	switch e := err.(type) {
+	case *Error:
+		return e.Type()
+	case *MultiError:
 		return e.Type()
 	}
 
 	return TypeUnknown
 }
 
-// FilterByType recursively searches an error chain and returns all errorsx.Error
+// FilterByType searches an error tree and returns all errorsx.Error
 // instances that match the specified ErrorType. This function traverses both
 // simple error chains (via Unwrap()) and joined errors (multiple errors).
 //
-// The function prevents duplicate results by tracking already-seen errors.
+// Errors whose type is a descendant of typ in the error type hierarchy
+// (see RegisterType) match as well.
+//
+// Every node of the tree is visited exactly once with Walk, in depth-first
+// pre-order, and only nodes that are themselves *Error values are checked.
+// *Error values behind plain wrappers such as fmt.Errorf("%w") are therefore
+// all found, in the order they appear in the tree, and an *Error reached
+// through several branches is reported once.
 //
 // Example:
 //
@@ -424,49 +520,13 @@ func Type(err error) ErrorType {
 //
 // Returns an empty slice if no errors of the specified type are found.
 func FilterByType(err error, typ ErrorType) []*Error {
-	var result []*Error
-	seen := map[*Error]struct{}{}
-	var walk func(error)
-
-	walk = func(err error) {
-		if err == nil {
-			return
-		}
-
-		// Extract and type check *errorsx.Error
-		var e *Error
-		if errors.As(err, &e) {
-			if _, ok := seen[e]; ok {
-				return
-			}
-			seen[e] = struct{}{}
-			if e.Type() == typ {
-				result = append(result, e)
-			}
-		}
-
-		// Handle joinError: when Unwrap() returns []error
-		if unwrapper, ok := err.(interface{ Unwrap() []error }); ok {
-			for _, ue := range unwrapper.Unwrap() {
-				if ue != nil {
-					walk(ue)
-				}
-			}
-			return
-		}
-
-		// Handle normal Unwrap() returning a single error
-		if ue := errors.Unwrap(err); ue != nil {
-			walk(ue)
-		}
-	}
-
-	walk(err)
-
-	return result
+	return FilterBy(err, func(e *Error) bool {
+		return IsTypeOf(e.Type(), typ)
+	})
 }
 
-// HasType checks if an error chain contains any errors of the specified ErrorType.
+// HasType checks if an error chain contains any errors of the specified ErrorType,
+// including errors whose type is a descendant of typ in the error type hierarchy.
 // This is a convenience function that returns true if FilterByType would return
 // a non-empty slice.
 //
@@ -480,5 +540,13 @@ func FilterByType(err error, typ ErrorType) []*Error {
 // This function is more efficient than FilterByType when you only need to check
 // for the presence of a specific error type without accessing the errors themselves.
 func HasType(err error, typ ErrorType) bool {
-	return len(FilterByType(err, typ)) > 0
+	found := false
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && IsTypeOf(e.Type(), typ) {
+			found = true
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return found
 }
@@ -0,0 +1,117 @@
+package errorsx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// CauseTypeInferer infers the type of an error from the Go types and sentinel
+// values found anywhere in its chain.
+//
+// Unlike StackTraceInferer, which only reports the reflected name of the root
+// cause, CauseTypeInferer matches real Go types with errors.As and sentinel
+// values with errors.Is, so wrapped causes at any depth are recognized.
+// Registrations are tried in order and the first match wins.
+//
+// Example:
+//
+//	inferer := errorsx.NewCauseTypeInferer()
+//	errorsx.RegisterCause[*json.SyntaxError](inferer, errorsx.TypeValidation)
+//	inferer.RegisterTarget(context.DeadlineExceeded, errorsx.TypeTimeout)
+//
+//	errorsx.SetGlobalTypeInferer(inferer.Infer)
+//
+// CauseTypeInferer is safe for concurrent use.
+type CauseTypeInferer struct {
+	mu      sync.RWMutex
+	entries []causeEntry
+}
+
+type causeEntry struct {
+	matches func(error) bool
+	typ     ErrorType
+	desc    string
+}
+
+// defaultCauseInferer is the CauseTypeInferer used by Register and RegisterTarget.
+var defaultCauseInferer = NewCauseTypeInferer() //nolint:gochecknoglobals
+
+// NewCauseTypeInferer creates an empty CauseTypeInferer.
+func NewCauseTypeInferer() *CauseTypeInferer {
+	return &CauseTypeInferer{}
+}
+
+// DefaultCauseTypeInferer returns the CauseTypeInferer that Register and
+// RegisterTarget add to. It is not consulted unless it is installed, for
+// example with SetGlobalTypeInferer(errorsx.DefaultCauseTypeInferer().Infer).
+func DefaultCauseTypeInferer() *CauseTypeInferer {
+	return defaultCauseInferer
+}
+
+// RegisterCause registers typ for errors whose chain contains an error of type T.
+//
+// Example:
+//
+//	errorsx.RegisterCause[*fs.PathError](inferer, TypeStorage)
+func RegisterCause[T error](c *CauseTypeInferer, typ ErrorType) {
+	c.register(func(err error) bool {
+		var target T
+		return errors.As(err, &target)
+	}, typ, "as "+reflect.TypeOf((*T)(nil)).Elem().String())
+}
+
+// RegisterTarget registers typ for errors whose chain contains target,
+// as reported by errors.Is.
+func (c *CauseTypeInferer) RegisterTarget(target error, typ ErrorType) {
+	c.register(func(err error) bool {
+		return errors.Is(err, target)
+	}, typ, fmt.Sprintf("is %q", target))
+}
+
+// Infer is an ErrorTypeInferer that returns the type of the first registration
+// matching the error's chain, or TypeUnknown if none matches.
+func (c *CauseTypeInferer) Infer(e *Error) ErrorType {
+	c.mu.RLock()
+	entries := c.entries
+	c.mu.RUnlock()
+
+	for _, entry := range entries {
+		if entry.matches(e) {
+			e.recordRule("%s", entry.desc)
+			return entry.typ
+		}
+	}
+	return TypeUnknown
+}
+
+func (c *CauseTypeInferer) register(matches func(error) bool, typ ErrorType, desc string) {
+	c.mu.Lock()
+	// Copy on write, so that Infer can iterate without holding the lock.
+	c.entries = append(c.entries[:len(c.entries):len(c.entries)], causeEntry{matches: matches, typ: typ, desc: desc})
+	c.mu.Unlock()
+
+	// Types cached before the registration may be stale.
+	infererGeneration.Add(1)
+}
+
+// Register registers typ for errors whose chain contains an error of type T
+// on the default CauseTypeInferer.
+//
+// Example:
+//
+//	func init() {
+//		errorsx.Register[*json.SyntaxError](errorsx.TypeValidation)
+//		errorsx.RegisterTarget(context.DeadlineExceeded, errorsx.TypeTimeout)
+//		errorsx.SetGlobalTypeInferer(errorsx.DefaultCauseTypeInferer().Infer)
+//	}
+func Register[T error](typ ErrorType) {
+	RegisterCause[T](defaultCauseInferer, typ)
+}
+
+// RegisterTarget registers typ for errors whose chain contains target on the
+// default CauseTypeInferer.
+func RegisterTarget(target error, typ ErrorType) {
+	defaultCauseInferer.RegisterTarget(target, typ)
+}
@@ -0,0 +1,88 @@
+package errorsx_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type CauseTypeInfererSuite struct {
+	suite.Suite
+	inferer *errorsx.CauseTypeInferer
+}
+
+func (s *CauseTypeInfererSuite) SetupTest() {
+	s.inferer = errorsx.NewCauseTypeInferer()
+	errorsx.RegisterCause[*json.SyntaxError](s.inferer, errorsx.TypeValidation)
+	s.inferer.RegisterTarget(context.DeadlineExceeded, errorsx.TypeTimeout)
+	s.inferer.RegisterTarget(fs.ErrNotExist, errorsx.TypeNotFound)
+}
+
+func (s *CauseTypeInfererSuite) TestMatchesGoType() {
+	var syntaxErr *json.SyntaxError
+	cause := json.Unmarshal([]byte("{"), &map[string]any{})
+	s.Require().ErrorAs(cause, &syntaxErr)
+
+	err := errorsx.New("request.decode_failed", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(cause)
+	s.Equal(errorsx.TypeValidation, err.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestMatchesTargetAnywhereInChain() {
+	root := fmt.Errorf("query: %w", context.DeadlineExceeded)
+	middle := errorsx.New("db.query_failed").WithCause(root)
+	outer := errorsx.New("order.load_failed", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(fmt.Errorf("load: %w", middle))
+
+	s.Equal(errorsx.TypeTimeout, outer.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestMatchesInsideJoinedErrors() {
+	cause := errors.Join(errors.New("other"), fmt.Errorf("open: %w", fs.ErrNotExist))
+	err := errorsx.New("config.load_failed", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(cause)
+
+	s.Equal(errorsx.TypeNotFound, err.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestFirstRegistrationWins() {
+	s.inferer.RegisterTarget(context.DeadlineExceeded, errorsx.TypeUnavailable)
+
+	err := errorsx.New("db.timeout", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(context.DeadlineExceeded)
+	s.Equal(errorsx.TypeTimeout, err.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestNoMatch() {
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(errors.New("boom"))
+	s.Equal(errorsx.TypeUnknown, err.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestDefaultInferer() {
+	type quotaError struct{ error }
+	errorsx.Register[quotaError](errorsx.TypeResourceExhausted)
+	errorsx.RegisterTarget(context.Canceled, errorsx.TypeCanceled)
+
+	errorsx.SetGlobalTypeInferer(errorsx.DefaultCauseTypeInferer().Infer)
+	defer errorsx.ClearGlobalTypeInferer()
+
+	quota := errorsx.New("upload.failed").WithCause(quotaError{errors.New("quota exceeded")})
+	s.Equal(errorsx.TypeResourceExhausted, quota.Type())
+
+	canceled := errorsx.New("request.aborted").WithCause(context.Canceled)
+	s.Equal(errorsx.TypeCanceled, canceled.Type())
+}
+
+func (s *CauseTypeInfererSuite) TestRegistrationInvalidatesCachedTypes() {
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(s.inferer.Infer)).WithCause(context.Canceled)
+	s.Equal(errorsx.TypeUnknown, err.Type())
+
+	s.inferer.RegisterTarget(context.Canceled, errorsx.TypeCanceled)
+	s.Equal(errorsx.TypeCanceled, err.Type())
+}
+
+func TestCauseTypeInfererSuite(t *testing.T) {
+	suite.Run(t, new(CauseTypeInfererSuite))
+}
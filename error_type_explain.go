@@ -0,0 +1,200 @@
+package errorsx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// ExplanationSource identifies the step of type resolution that produced a result.
+type ExplanationSource string
+
+const (
+	// SourceOverride is a rule of the RuleSet installed with SetRuleSet.
+	SourceOverride ExplanationSource = "override"
+
+	// SourceExplicit is the type set with WithType.
+	SourceExplicit ExplanationSource = "explicit"
+
+	// SourceInstance is the inferer set with WithTypeInferer.
+	SourceInstance ExplanationSource = "instance"
+
+	// SourceFactory is an inferer of the Factory that created the error.
+	SourceFactory ExplanationSource = "factory"
+
+	// SourceRegistered is an inferer registered with RegisterTypeInferer or SetGlobalTypeInferer.
+	SourceRegistered ExplanationSource = "registered"
+
+	// SourceDefault is the fallback to TypeUnknown.
+	SourceDefault ExplanationSource = "default"
+)
+
+// ExplanationStep is a step that Type went through to resolve the type of an error.
+type ExplanationStep struct {
+	// Source is the kind of step.
+	Source ExplanationSource `json:"source"`
+
+	// Name is the name of the registered inferer, for SourceRegistered.
+	Name string `json:"name,omitempty"`
+
+	// Priority is the priority of the registered inferer, for SourceRegistered.
+	Priority int `json:"priority,omitempty"`
+
+	// Type is the result of the step. TypeUnknown means the step did not decide.
+	Type ErrorType `json:"type"`
+
+	// Rule describes the rule that matched, for pattern-based inferers.
+	Rule string `json:"rule,omitempty"`
+
+	// Decided reports whether this step determined the type.
+	Decided bool `json:"decided"`
+}
+
+// TypeExplanation describes how the type of an error was resolved.
+type TypeExplanation struct {
+	// ID is the ID of the explained error, or empty if err has no errorsx.Error.
+	ID string `json:"id,omitempty"`
+
+	// Type is the resolved type, as returned by Type.
+	Type ErrorType `json:"type"`
+
+	// Steps are the steps taken, in order. The last step decided the type.
+	Steps []ExplanationStep `json:"steps"`
+}
+
+// String renders the explanation as text, one step per line.
+//
+// Example output:
+//
+//	type of "payment.declined" is payment.error
+//	  instance inferer: errorsx.unknown
+//	  registered inferer "global" (priority 0): errorsx.unknown
+//	  registered inferer "payments" (priority 10): payment.error [rule "payment.**"] (decided)
+func (x TypeExplanation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "type of %q is %s", x.ID, x.Type)
+	for _, step := range x.Steps {
+		b.WriteString("\n  ")
+		switch step.Source {
+		case SourceOverride:
+			b.WriteString("rule set override")
+		case SourceExplicit:
+			b.WriteString("explicit type")
+		case SourceInstance:
+			b.WriteString("instance inferer")
+		case SourceFactory:
+			b.WriteString("factory inferer")
+		case SourceRegistered:
+			fmt.Fprintf(&b, "registered inferer %q (priority %d)", step.Name, step.Priority)
+		case SourceDefault:
+			b.WriteString("default")
+		}
+		fmt.Fprintf(&b, ": %s", step.Type)
+		if step.Rule != "" {
+			fmt.Fprintf(&b, " [rule %s]", step.Rule)
+		}
+		if step.Decided {
+			b.WriteString(" (decided)")
+		}
+	}
+	return b.String()
+}
+
+// ExplainType explains how the type of the first errorsx.Error in the chain of
+// err is resolved: the rule set override, the explicit type, the instance
+// inferer, the factory inferers and every registered inferer consulted, and,
+// for pattern-based inferers such as RulesInferer, IDPatternInferer,
+// IDContainsInferer, CauseTypeInferer, StdlibInferer and RuleSet, the rule
+// that matched.
+//
+// ExplainType runs the inferers again and ignores cached types.
+//
+// Example:
+//
+//	fmt.Println(errorsx.ExplainType(err))
+func ExplainType(err error) TypeExplanation {
+	var e *Error
+	if !errors.As(err, &e) {
+		return TypeExplanation{
+			Type:  TypeUnknown,
+			Steps: []ExplanationStep{{Source: SourceDefault, Type: TypeUnknown, Decided: true}},
+		}
+	}
+
+	x := TypeExplanation{ID: e.id}
+	decide := func(step ExplanationStep) bool {
+		step.Decided = step.Type != TypeUnknown || step.Source == SourceDefault
+		x.Steps = append(x.Steps, step)
+		if step.Decided {
+			x.Type = step.Type
+		}
+		return step.Decided
+	}
+
+	if rule := e.overrideRule(); rule != nil && rule.Set.Type != "" {
+		decide(ExplanationStep{Source: SourceOverride, Type: rule.Set.Type, Rule: rule.desc})
+		return x
+	}
+	if e.errType != TypeUnknown {
+		decide(ExplanationStep{Source: SourceExplicit, Type: e.errType})
+		return x
+	}
+
+	// Inferers record matched rules on a private copy of the error.
+	recorder := &explainRecorder{}
+	probe := e.clone()
+	probe.explain = recorder
+
+	if e.typeInferer != nil {
+		typ, _ := safeInfer(e.typeInferer, probe)
+		if decide(ExplanationStep{Source: SourceInstance, Type: typ, Rule: recorder.take()}) {
+			return x
+		}
+	}
+	for _, inferer := range e.config().TypeInferers {
+		typ, _ := safeInfer(inferer, probe)
+		if decide(ExplanationStep{Source: SourceFactory, Type: typ, Rule: recorder.take()}) {
+			return x
+		}
+	}
+	for _, r := range loadInferers() {
+		typ, _ := safeInfer(r.Inferer, probe)
+		step := ExplanationStep{Source: SourceRegistered, Name: r.Name, Priority: r.Priority, Type: typ, Rule: recorder.take()}
+		if decide(step) {
+			return x
+		}
+	}
+	decide(ExplanationStep{Source: SourceDefault, Type: TypeUnknown})
+
+	return x
+}
+
+// explainRecorder collects the rule matched by a pattern-based inferer during ExplainType.
+type explainRecorder struct {
+	rule string
+}
+
+func (r *explainRecorder) take() string {
+	rule := r.rule
+	r.rule = ""
+	return rule
+}
+
+// recordRule records the rule that matched while the error is being explained.
+// It does nothing outside ExplainType.
+func (e *Error) recordRule(format string, args ...any) {
+	if e.explain != nil {
+		e.explain.rule = fmt.Sprintf(format, args...)
+	}
+}
+
+// explainTypeInJSON enables the type explanation in MarshalJSON output.
+var explainTypeInJSON atomic.Bool //nolint:gochecknoglobals
+
+// SetExplainTypeInJSON enables or disables a "type_explanation" field with the
+// result of ExplainType in the JSON output of errors.
+// This is intended for debugging classification and is disabled by default.
+func SetExplainTypeInJSON(enabled bool) {
+	explainTypeInJSON.Store(enabled)
+}
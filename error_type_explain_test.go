@@ -0,0 +1,122 @@
+package errorsx_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExplainTypeSuite struct {
+	suite.Suite
+}
+
+func (s *ExplainTypeSuite) TearDownTest() {
+	errorsx.ClearGlobalTypeInferer()
+	errorsx.SetExplainTypeInJSON(false)
+}
+
+func (s *ExplainTypeSuite) TestExplicitType() {
+	x := errorsx.ExplainType(errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation)))
+
+	s.Equal("input.invalid", x.ID)
+	s.Equal(errorsx.TypeValidation, x.Type)
+	s.Equal([]errorsx.ExplanationStep{
+		{Source: errorsx.SourceExplicit, Type: errorsx.TypeValidation, Decided: true},
+	}, x.Steps)
+}
+
+func (s *ExplainTypeSuite) TestRegisteredInfererWithRule() {
+	errorsx.SetGlobalTypeInferer(errorsx.IDContainsInferer(map[string]errorsx.ErrorType{"cache": "test.cache"}))
+	defer errorsx.RegisterTypeInferer("test.payments", 10, errorsx.RulesInferer(errorsx.Rules{
+		{Pattern: "order.*", Type: "test.order"},
+		{Pattern: "payment.**", Type: "test.payment"},
+	}, errorsx.FirstMatch))()
+
+	err := errorsx.New("payment.card.declined", errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType {
+		return errorsx.TypeUnknown
+	}))
+	x := errorsx.ExplainType(fmt.Errorf("checkout: %w", err))
+
+	s.Equal(errorsx.ErrorType("test.payment"), x.Type)
+	s.Equal(err.Type(), x.Type)
+	s.Require().Len(x.Steps, 2)
+	s.Equal(errorsx.ExplanationStep{Source: errorsx.SourceInstance, Type: errorsx.TypeUnknown}, x.Steps[0])
+	s.Equal(errorsx.ExplanationStep{
+		Source:   errorsx.SourceRegistered,
+		Name:     "test.payments",
+		Priority: 10,
+		Type:     "test.payment",
+		Rule:     `"payment.**"`,
+		Decided:  true,
+	}, x.Steps[1])
+}
+
+func (s *ExplainTypeSuite) TestDefault() {
+	errorsx.SetGlobalTypeInferer(errorsx.IDPatternInferer(map[string]errorsx.ErrorType{"db.*": "test.db"}))
+
+	x := errorsx.ExplainType(errorsx.New("order.failed"))
+	s.Equal(errorsx.TypeUnknown, x.Type)
+	s.Require().Len(x.Steps, 2)
+	s.Equal(errorsx.SourceRegistered, x.Steps[0].Source)
+	s.False(x.Steps[0].Decided)
+	s.Empty(x.Steps[0].Rule)
+	s.Equal(errorsx.ExplanationStep{Source: errorsx.SourceDefault, Type: errorsx.TypeUnknown, Decided: true}, x.Steps[1])
+}
+
+func (s *ExplainTypeSuite) TestCauseAndStdlibRules() {
+	inferer := errorsx.NewCauseTypeInferer()
+	errorsx.RegisterCause[*json.SyntaxError](inferer, errorsx.TypeValidation)
+	inferer.RegisterTarget(context.Canceled, errorsx.TypeCanceled)
+
+	cause := json.Unmarshal([]byte("{"), &map[string]any{})
+	x := errorsx.ExplainType(errorsx.New("request.decode_failed", errorsx.WithTypeInferer(inferer.Infer)).WithCause(cause))
+	s.Equal("as *json.SyntaxError", x.Steps[0].Rule)
+
+	x = errorsx.ExplainType(errorsx.New("request.aborted", errorsx.WithTypeInferer(inferer.Infer)).WithCause(context.Canceled))
+	s.Equal(`is "context canceled"`, x.Steps[0].Rule)
+
+	x = errorsx.ExplainType(errorsx.New("db.timeout", errorsx.WithTypeInferer(errorsx.StdlibInferer())).WithCause(context.DeadlineExceeded))
+	s.Equal(errorsx.StdlibDeadlineExceededID, x.Steps[0].Rule)
+}
+
+func (s *ExplainTypeSuite) TestNonErrorsxError() {
+	x := errorsx.ExplainType(errors.New("plain"))
+	s.Empty(x.ID)
+	s.Equal(errorsx.TypeUnknown, x.Type)
+	s.Len(x.Steps, 1)
+}
+
+func (s *ExplainTypeSuite) TestString() {
+	errorsx.SetGlobalTypeInferer(errorsx.RulesInferer(errorsx.Rules{{Pattern: "payment.**", Type: "test.payment"}}, errorsx.FirstMatch))
+
+	x := errorsx.ExplainType(errorsx.New("payment.declined"))
+	s.Equal(`type of "payment.declined" is test.payment
+  registered inferer "global" (priority 0): test.payment [rule "payment.**"] (decided)`, x.String())
+}
+
+func (s *ExplainTypeSuite) TestJSON() {
+	err := errorsx.New("payment.declined", errorsx.WithType(errorsx.TypeConflict))
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	s.NotContains(string(data), "type_explanation")
+
+	errorsx.SetExplainTypeInJSON(true)
+	data, marshalErr = json.Marshal(err)
+	s.Require().NoError(marshalErr)
+
+	var result struct {
+		Explanation errorsx.TypeExplanation `json:"type_explanation"`
+	}
+	s.Require().NoError(json.Unmarshal(data, &result))
+	s.Equal(errorsx.ExplainType(err), result.Explanation)
+}
+
+func TestExplainTypeSuite(t *testing.T) {
+	suite.Run(t, new(ExplainTypeSuite))
+}
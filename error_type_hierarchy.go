@@ -0,0 +1,124 @@
+package errorsx
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// Root error types of the default hierarchy.
+const (
+	// TypeClientError is the parent of error types caused by the request or input of the caller.
+	TypeClientError ErrorType = "errorsx.client_error"
+
+	// TypeServerError is the parent of error types caused by the system itself.
+	TypeServerError ErrorType = "errorsx.server_error"
+)
+
+var (
+	// typeParents maps each registered ErrorType to its parent. The map is
+	// replaced, never modified, so that readers need no lock. Until the first
+	// registration it is builtinTypeParents.
+	typeParents      atomic.Pointer[map[ErrorType]ErrorType] //nolint:gochecknoglobals
+	typeParentsMutex sync.Mutex                              //nolint:gochecknoglobals
+
+	// builtinTypeParents is the default hierarchy of the built-in types:
+	//
+	//	TypeClientError
+	//	├── TypeValidation, TypeNotFound, TypeUnauthenticated, TypePermissionDenied
+	//	├── TypeConflict
+	//	│   └── TypeAlreadyExists
+	//	└── TypePreconditionFailed, TypeRateLimited, TypeCanceled
+	//	TypeServerError
+	//	└── TypeInitialization, TypeUnknown, TypeTimeout, TypeUnavailable,
+	//	    TypeInternal, TypeNotImplemented, TypeResourceExhausted, TypeDataLoss
+	builtinTypeParents = defaultTypeParents() //nolint:gochecknoglobals
+)
+
+func loadTypeParents() map[ErrorType]ErrorType {
+	if parents := typeParents.Load(); parents != nil {
+		return *parents
+	}
+	return builtinTypeParents
+}
+
+// RegisterType registers typ as a child of parent in the error type hierarchy.
+// Registering a type again replaces its parent; an empty parent removes it
+// from the hierarchy.
+//
+// Once registered, HasType, FilterByType and ByType match child types when
+// asked for an ancestor, so shared middleware can handle broad categories
+// while domains keep precise types.
+//
+// Example:
+//
+//	const TypeEmailInvalid errorsx.ErrorType = "user.email_invalid"
+//
+//	func init() {
+//		errorsx.RegisterType(TypeEmailInvalid, errorsx.TypeValidation)
+//	}
+//
+//	err := errorsx.New("user.email_invalid", errorsx.WithType(TypeEmailInvalid))
+//	errorsx.HasType(err, errorsx.TypeValidation)  // true
+//	errorsx.HasType(err, errorsx.TypeClientError) // true
+//
+// RegisterType panics if the registration would create a cycle.
+func RegisterType(typ, parent ErrorType) {
+	typeParentsMutex.Lock()
+	defer typeParentsMutex.Unlock()
+
+	parents := maps.Clone(loadTypeParents())
+	if parent == "" {
+		delete(parents, typ)
+		typeParents.Store(&parents)
+		return
+	}
+	for p := parent; p != ""; p = parents[p] {
+		if p == typ {
+			panic(fmt.Sprintf("errorsx: registering %q under %q creates a cycle", typ, parent))
+		}
+	}
+	parents[typ] = parent
+	typeParents.Store(&parents)
+}
+
+// TypeParent returns the parent of typ in the error type hierarchy.
+// Returns false if typ has no registered parent.
+func TypeParent(typ ErrorType) (ErrorType, bool) {
+	parent, ok := loadTypeParents()[typ]
+	return parent, ok
+}
+
+// TypeAncestors returns the ancestors of typ, starting with its parent and
+// ending with the root of its hierarchy.
+// Returns an empty slice if typ has no registered parent.
+//
+// Example:
+//
+//	errorsx.TypeAncestors(errorsx.TypeValidation)
+//	// []ErrorType{errorsx.TypeClientError}
+func TypeAncestors(typ ErrorType) []ErrorType {
+	parents := loadTypeParents()
+	var ancestors []ErrorType
+	for p, ok := parents[typ]; ok; p, ok = parents[p] {
+		ancestors = append(ancestors, p)
+	}
+	return ancestors
+}
+
+// IsTypeOf reports whether typ equals ancestor or is one of its descendants
+// in the error type hierarchy.
+func IsTypeOf(typ, ancestor ErrorType) bool {
+	if typ == ancestor {
+		return true
+	}
+
+	parents := loadTypeParents()
+	for p, ok := parents[typ]; ok; p, ok = parents[p] {
+		if p == ancestor {
+			return true
+		}
+	}
+	return false
+}
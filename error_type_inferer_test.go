@@ -292,6 +292,28 @@ func TestIDContainsInferer(t *testing.T) {
 	}
 }
 
+func TestIDInferersCopyMap(t *testing.T) {
+	// 生成後にマップを変更してもInfererに影響しないことのテスト
+	patterns := map[string]ErrorType{"auth.*": TypeAuthentication}
+	substrings := map[string]ErrorType{"auth": TypeAuthentication}
+	patternInferer := IDPatternInferer(patterns)
+	containsInferer := IDContainsInferer(substrings)
+
+	delete(patterns, "auth.*")
+	delete(substrings, "auth")
+	patterns["db.*"] = TypeDatabase
+	substrings["db"] = TypeDatabase
+
+	for name, inferer := range map[string]ErrorTypeInferer{"pattern": patternInferer, "contains": containsInferer} {
+		if got := inferer(New("auth.failed")); got != TypeAuthentication {
+			t.Errorf("%s: Type() of deleted key = %q, want %v", name, got, TypeAuthentication)
+		}
+		if got := inferer(New("db.failed")); got != TypeUnknown {
+			t.Errorf("%s: Type() of added key = %q, want %v", name, got, TypeUnknown)
+		}
+	}
+}
+
 func TestChainInferers(t *testing.T) {
 	// Infererチェインのテスト
 	authInferer := IDContainsInferer(map[string]ErrorType{
@@ -677,3 +699,92 @@ func TestStackTraceInferer_WithCallerStackAndExplicitType(t *testing.T) {
 		}
 	})
 }
+
+func TestTypeCache(t *testing.T) {
+	ClearGlobalTypeInferer()
+	defer ClearGlobalTypeInferer()
+
+	calls := 0
+	inferer := func(e *Error) ErrorType {
+		calls++
+		return TypeDatabase
+	}
+
+	err := New("db.failed", WithTypeInferer(inferer))
+	for i := 0; i < 3; i++ {
+		if got := err.Type(); got != TypeDatabase {
+			t.Errorf("Type() = %v, want %v", got, TypeDatabase)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("inferer called %d times, want 1", calls)
+	}
+
+	// コピーはキャッシュを共有しない
+	_ = err.WithReason("reason").Type()
+	if calls != 2 {
+		t.Errorf("inferer called %d times after copy, want 2", calls)
+	}
+
+	// WithType/WithTypeInfererでキャッシュが無効になる
+	if got := err.WithType(TypeNetwork).Type(); got != TypeNetwork {
+		t.Errorf("WithType().Type() = %v, want %v", got, TypeNetwork)
+	}
+	retyped := err.WithTypeInferer(func(*Error) ErrorType { return TypeAuthentication })
+	if got := retyped.Type(); got != TypeAuthentication {
+		t.Errorf("WithTypeInferer().Type() = %v, want %v", got, TypeAuthentication)
+	}
+}
+
+func TestTypeCache_GlobalInfererChange(t *testing.T) {
+	ClearGlobalTypeInferer()
+	defer ClearGlobalTypeInferer()
+
+	err := New("network.down")
+	if got := err.Type(); got != TypeUnknown {
+		t.Errorf("Type() = %v, want %v", got, TypeUnknown)
+	}
+
+	// グローバルInfererの変更でキャッシュが無効になる
+	SetGlobalTypeInferer(IDContainsInferer(map[string]ErrorType{"network": TypeNetwork}))
+	if got := err.Type(); got != TypeNetwork {
+		t.Errorf("Type() after SetGlobalTypeInferer = %v, want %v", got, TypeNetwork)
+	}
+
+	ClearGlobalTypeInferer()
+	if got := err.Type(); got != TypeUnknown {
+		t.Errorf("Type() after ClearGlobalTypeInferer = %v, want %v", got, TypeUnknown)
+	}
+}
+
+func TestTypeCache_ZeroValueError(t *testing.T) {
+	err := &Error{id: "literal", errType: TypeUnknown}
+	if got := err.Type(); got != TypeUnknown {
+		t.Errorf("Type() = %v, want %v", got, TypeUnknown)
+	}
+}
+
+func BenchmarkType_Cache(b *testing.B) {
+	inferer := StackTraceInferer(func(_ ErrorType, frame runtime.Frame, _ string) ErrorType {
+		if strings.Contains(frame.Function, "Benchmark") {
+			return TypeDatabase
+		}
+		return TypeUnknown
+	})
+	cached := New("db.failed", WithTypeInferer(inferer)).WithCallerStack()
+	uncached := *cached
+	uncached.typeCache = nil
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = cached.Type()
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = uncached.Type()
+		}
+	})
+}
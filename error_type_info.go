@@ -0,0 +1,222 @@
+package errorsx
+
+import (
+	"errors"
+	"log/slog"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// Severity describes how serious an error is for alerting and reporting.
+type Severity int
+
+const (
+	// SeverityUnspecified is the zero value, used when no severity is known.
+	SeverityUnspecified Severity = iota
+
+	// SeverityInfo describes expected errors that need no attention, such as invalid input.
+	SeverityInfo
+
+	// SeverityWarning describes errors that may need attention if they persist.
+	SeverityWarning
+
+	// SeverityError describes errors that need attention.
+	SeverityError
+
+	// SeverityCritical describes errors that need immediate attention.
+	SeverityCritical
+)
+
+// String returns a human-readable name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	case SeverityUnspecified:
+	}
+	return "unspecified"
+}
+
+// TypeInfo holds the default attributes of errors of an ErrorType.
+// Errors fall back to these defaults when they have no explicit value.
+type TypeInfo struct {
+	// HTTPStatus is the default HTTP status code. 0 means no default.
+	HTTPStatus int
+
+	// GRPCCode is the default gRPC status code, with the values of
+	// google.golang.org/grpc/codes. 0 (OK) means no default.
+	GRPCCode uint32
+
+	// Retryable reports whether errors of the type are retryable by default.
+	Retryable bool
+
+	// Severity is the severity of errors of the type.
+	Severity Severity
+
+	// ExitCode is the process exit code for command line tools failing with errors of the type.
+	ExitCode int
+
+	// LogLevel is the level at which errors of the type should be logged.
+	LogLevel slog.Level
+}
+
+var (
+	// typeInfos maps each ErrorType to its registered metadata. The map is
+	// replaced, never modified, so that readers need no lock. Until the first
+	// registration it is builtinTypeInfos.
+	typeInfos      atomic.Pointer[map[ErrorType]TypeInfo] //nolint:gochecknoglobals
+	typeInfosMutex sync.Mutex                             //nolint:gochecknoglobals
+
+	// builtinTypeInfos holds the metadata of the built-in types.
+	builtinTypeInfos = defaultTypeInfos() //nolint:gochecknoglobals
+)
+
+func loadTypeInfos() map[ErrorType]TypeInfo {
+	if infos := typeInfos.Load(); infos != nil {
+		return *infos
+	}
+	return builtinTypeInfos
+}
+
+// RegisterTypeInfo registers the default attributes of errors of typ,
+// replacing any previous registration.
+//
+// Types without their own registration inherit the metadata of their nearest
+// registered ancestor in the error type hierarchy (see RegisterType).
+//
+// Example:
+//
+//	const TypePaymentDeclined errorsx.ErrorType = "payment.declined"
+//
+//	func init() {
+//		errorsx.RegisterTypeInfo(TypePaymentDeclined, errorsx.TypeInfo{
+//			HTTPStatus: 402,
+//			Severity:   errorsx.SeverityWarning,
+//			LogLevel:   slog.LevelWarn,
+//		})
+//	}
+//
+//	err := errorsx.New("payment.card_declined", errorsx.WithType(TypePaymentDeclined))
+//	err.HTTPStatus() // 402
+func RegisterTypeInfo(typ ErrorType, info TypeInfo) {
+	typeInfosMutex.Lock()
+	defer typeInfosMutex.Unlock()
+
+	infos := maps.Clone(loadTypeInfos())
+	infos[typ] = info
+	typeInfos.Store(&infos)
+}
+
+// LookupTypeInfo returns the metadata registered for typ, or for its nearest
+// registered ancestor. Returns false if neither typ nor any ancestor is registered.
+func LookupTypeInfo(typ ErrorType) (TypeInfo, bool) {
+	infos := loadTypeInfos()
+	parents := loadTypeParents()
+	for t, ok := typ, true; ok; t, ok = parents[t] {
+		if info, found := infos[t]; found {
+			return info, true
+		}
+	}
+	return TypeInfo{}, false
+}
+
+// typeInfo returns the metadata of the error's type, or the zero TypeInfo,
+// with the overrides of the installed RuleSet applied.
+func (e *Error) typeInfo() TypeInfo {
+	info, _ := LookupTypeInfo(e.Type())
+
+	o := e.override()
+	if o.HTTPStatus != nil {
+		info.HTTPStatus = *o.HTTPStatus
+	}
+	if o.Retryable != nil {
+		info.Retryable = *o.Retryable
+	}
+	if o.LogLevel != nil {
+		info.LogLevel = *o.LogLevel
+	}
+	return info
+}
+
+// Severity returns the severity registered for the error's type.
+func (e *Error) Severity() Severity {
+	return e.typeInfo().Severity
+}
+
+// GRPCCode returns the gRPC status code registered for the error's type,
+// with the values of google.golang.org/grpc/codes.
+//
+// Example:
+//
+//	return status.Error(codes.Code(err.GRPCCode()), err.Error())
+func (e *Error) GRPCCode() uint32 {
+	return e.typeInfo().GRPCCode
+}
+
+// ExitCode returns the process exit code registered for the error's type.
+func (e *Error) ExitCode() int {
+	return e.typeInfo().ExitCode
+}
+
+// LogLevel returns the log level registered for the error's type.
+func (e *Error) LogLevel() slog.Level {
+	return e.typeInfo().LogLevel
+}
+
+// SeverityOf returns the severity of the first errorsx.Error in the error chain.
+// Errors from other packages are treated like errors of TypeUnknown.
+// Returns SeverityUnspecified if err is nil.
+func SeverityOf(err error) Severity {
+	return typeInfoOf(err).Severity
+}
+
+// GRPCCodeOf returns the gRPC status code of the first errorsx.Error in the error chain.
+// Errors from other packages are treated like errors of TypeUnknown.
+// Returns 0 (OK) if err is nil.
+func GRPCCodeOf(err error) uint32 {
+	return typeInfoOf(err).GRPCCode
+}
+
+// ExitCodeOf returns the exit code of the first errorsx.Error in the error chain.
+// Errors from other packages are treated like errors of TypeUnknown.
+// Returns 0 if err is nil.
+//
+// Example:
+//
+//	if err := run(); err != nil {
+//		fmt.Fprintln(os.Stderr, err)
+//		os.Exit(errorsx.ExitCodeOf(err))
+//	}
+func ExitCodeOf(err error) int {
+	return typeInfoOf(err).ExitCode
+}
+
+// LogLevelOf returns the log level of the first errorsx.Error in the error chain.
+// Errors from other packages are treated like errors of TypeUnknown.
+// Returns slog.LevelInfo if err is nil.
+//
+// Example:
+//
+//	logger.Log(ctx, errorsx.LogLevelOf(err), "request failed", "error", err)
+func LogLevelOf(err error) slog.Level {
+	return typeInfoOf(err).LogLevel
+}
+
+func typeInfoOf(err error) TypeInfo {
+	if err == nil {
+		return TypeInfo{}
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.typeInfo()
+	}
+	info, _ := LookupTypeInfo(TypeUnknown)
+	return info
+}
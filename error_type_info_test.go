@@ -0,0 +1,123 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type TypeInfoSuite struct {
+	suite.Suite
+}
+
+func (s *TypeInfoSuite) TestBuiltinDefaults() {
+	notFound := errorsx.New("user.not_found", errorsx.WithType(errorsx.TypeNotFound))
+	s.Equal(404, notFound.HTTPStatus())
+	s.Equal(errorsx.SeverityInfo, notFound.Severity())
+	s.Equal(slog.LevelInfo, notFound.LogLevel())
+
+	validation := errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation))
+	s.Equal(400, validation.HTTPStatus())
+
+	initialization := errorsx.New("config.missing", errorsx.WithType(errorsx.TypeInitialization))
+	s.Equal(500, initialization.HTTPStatus())
+	s.Equal(errorsx.SeverityCritical, initialization.Severity())
+
+	untyped := errorsx.New("something.failed")
+	s.Equal(0, untyped.HTTPStatus())
+	s.Equal(errorsx.SeverityError, untyped.Severity())
+	s.Equal(slog.LevelError, untyped.LogLevel())
+	s.Equal(1, untyped.ExitCode())
+}
+
+func (s *TypeInfoSuite) TestExplicitValuesWin() {
+	err := errorsx.New("user.not_found", errorsx.WithType(errorsx.TypeNotFound), errorsx.WithHTTPStatus(410))
+	s.Equal(410, err.HTTPStatus())
+}
+
+func (s *TypeInfoSuite) TestRegisteredTypeInfo() {
+	const typeQuota errorsx.ErrorType = "test.quota_exceeded"
+	errorsx.RegisterTypeInfo(typeQuota, errorsx.TypeInfo{
+		HTTPStatus: 429,
+		Retryable:  true,
+		Severity:   errorsx.SeverityWarning,
+		ExitCode:   75,
+		LogLevel:   slog.LevelWarn,
+	})
+
+	err := errorsx.New("api.quota_exceeded", errorsx.WithType(typeQuota))
+	s.Equal(429, err.HTTPStatus())
+	s.True(err.IsRetryable())
+	s.True(errorsx.IsRetryable(fmt.Errorf("wrapped: %w", err)))
+	s.Equal(errorsx.SeverityWarning, errorsx.SeverityOf(err))
+	s.Equal(75, errorsx.ExitCodeOf(err))
+	s.Equal(slog.LevelWarn, errorsx.LogLevelOf(err))
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	var result map[string]any
+	s.Require().NoError(json.Unmarshal(data, &result))
+	s.Equal(float64(429), result["status"])
+	s.Equal(true, result["is_retryable"])
+}
+
+func (s *TypeInfoSuite) TestInheritsFromAncestors() {
+	const typeEmailInvalid errorsx.ErrorType = "test.info_email_invalid"
+	errorsx.RegisterType(typeEmailInvalid, errorsx.TypeValidation)
+	defer errorsx.RegisterType(typeEmailInvalid, "")
+
+	info, ok := errorsx.LookupTypeInfo(typeEmailInvalid)
+	s.True(ok)
+	s.Equal(400, info.HTTPStatus)
+
+	_, ok = errorsx.LookupTypeInfo("test.unregistered")
+	s.False(ok)
+}
+
+func (s *TypeInfoSuite) TestLookupDoesNotAllocate() {
+	const typeEmailInvalid errorsx.ErrorType = "test.info_email_invalid"
+	errorsx.RegisterType(typeEmailInvalid, errorsx.TypeValidation)
+	defer errorsx.RegisterType(typeEmailInvalid, "")
+
+	s.Zero(testing.AllocsPerRun(100, func() {
+		errorsx.LookupTypeInfo(typeEmailInvalid)
+		errorsx.IsTypeOf(typeEmailInvalid, errorsx.TypeClientError)
+	}))
+}
+
+func (s *TypeInfoSuite) TestChainFunctions() {
+	s.Equal(errorsx.SeverityUnspecified, errorsx.SeverityOf(nil))
+	s.Equal(0, errorsx.ExitCodeOf(nil))
+	s.Equal(errorsx.SeverityError, errorsx.SeverityOf(errors.New("plain")))
+	s.Equal(slog.LevelError, errorsx.LogLevelOf(errors.New("plain")))
+	s.Equal("critical", errorsx.SeverityCritical.String())
+}
+
+func (s *TypeInfoSuite) TestNotFoundIsUnified() {
+	byFlag := errorsx.NewNotFound("user.not_found")
+	s.Equal(errorsx.TypeNotFound, byFlag.Type())
+	s.Equal(404, byFlag.HTTPStatus())
+	s.True(errorsx.HasType(byFlag, errorsx.TypeNotFound))
+
+	byOption := errorsx.New("user.not_found", errorsx.WithNotFound())
+	s.Equal(errorsx.TypeNotFound, byOption.Type())
+
+	byType := errorsx.New("user.not_found", errorsx.WithType(errorsx.TypeNotFound))
+	s.True(byType.IsNotFound())
+	s.True(errorsx.IsNotFound(fmt.Errorf("wrapped: %w", byType)))
+
+	// A more specific type is kept while the error is still "not found"
+	const typeDomain errorsx.ErrorType = "test.domain"
+	specific := errorsx.New("user.not_found", errorsx.WithType(typeDomain), errorsx.WithNotFound())
+	s.Equal(typeDomain, specific.Type())
+	s.True(specific.IsNotFound())
+}
+
+func TestTypeInfoSuite(t *testing.T) {
+	suite.Run(t, new(TypeInfoSuite))
+}
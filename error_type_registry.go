@@ -0,0 +1,118 @@
+package errorsx
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// GlobalInfererName is the name under which SetGlobalTypeInferer registers its inferer.
+const GlobalInfererName = "global"
+
+// RegisteredInferer describes an inferer registered with RegisterTypeInferer.
+type RegisteredInferer struct {
+	// Name identifies the registration.
+	Name string
+
+	// Priority orders the registrations: higher priorities run first.
+	Priority int
+
+	// Inferer is the registered inferer.
+	Inferer ErrorTypeInferer
+
+	seq uint64
+}
+
+var (
+	// registeredInferers holds the registered inferers in evaluation order.
+	// It is replaced as a whole on every change, so readers never lock.
+	registeredInferers atomic.Pointer[[]RegisteredInferer] //nolint:gochecknoglobals
+
+	// registryMutex serializes writers of registeredInferers.
+	registryMutex sync.Mutex //nolint:gochecknoglobals
+
+	// registrySeq numbers registrations, so that unregister functions only
+	// remove the registration that created them.
+	registrySeq uint64 //nolint:gochecknoglobals
+)
+
+// RegisterTypeInferer adds an inferer that is consulted for errors without an
+// explicit type or a matching instance-specific inferer. Inferers run from the
+// highest to the lowest priority, ordered by name among equal priorities, and
+// the first result other than TypeUnknown wins.
+//
+// Registering a name again replaces the previous registration of that name.
+// The returned function removes the registration; it does nothing if the
+// name has been registered again since.
+//
+// This lets independent libraries classify their own errors without
+// overwriting each other's inferers.
+//
+// Example:
+//
+//	unregister := errorsx.RegisterTypeInferer("payments", 10, errorsx.RulesInferer(errorsx.Rules{
+//		{Pattern: "payment.**", Type: TypePayment},
+//	}, errorsx.FirstMatch))
+//	defer unregister()
+func RegisterTypeInferer(name string, priority int, inferer ErrorTypeInferer) (unregister func()) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	registrySeq++
+	seq := registrySeq
+
+	current := loadInferers()
+	next := make([]RegisteredInferer, 0, len(current)+1)
+	for _, r := range current {
+		if r.Name != name {
+			next = append(next, r)
+		}
+	}
+	next = append(next, RegisteredInferer{Name: name, Priority: priority, Inferer: inferer, seq: seq})
+	sort.SliceStable(next, func(i, j int) bool {
+		if next[i].Priority != next[j].Priority {
+			return next[i].Priority > next[j].Priority
+		}
+		return next[i].Name < next[j].Name
+	})
+	storeInferers(next)
+
+	return func() {
+		unregisterTypeInferer(name, seq)
+	}
+}
+
+// TypeInferers returns the registered inferers in evaluation order.
+func TypeInferers() []RegisteredInferer {
+	return append([]RegisteredInferer(nil), loadInferers()...)
+}
+
+// unregisterTypeInferer removes the registration of name. If seq is not zero,
+// only the registration with that sequence number is removed.
+func unregisterTypeInferer(name string, seq uint64) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	current := loadInferers()
+	next := make([]RegisteredInferer, 0, len(current))
+	for _, r := range current {
+		if r.Name != name || (seq != 0 && r.seq != seq) {
+			next = append(next, r)
+		}
+	}
+	if len(next) != len(current) {
+		storeInferers(next)
+	}
+}
+
+func loadInferers() []RegisteredInferer {
+	if inferers := registeredInferers.Load(); inferers != nil {
+		return *inferers
+	}
+	return nil
+}
+
+func storeInferers(inferers []RegisteredInferer) {
+	registeredInferers.Store(&inferers)
+	infererGeneration.Add(1)
+}
@@ -0,0 +1,103 @@
+package errorsx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type TypeInfererRegistrySuite struct {
+	suite.Suite
+}
+
+func (s *TypeInfererRegistrySuite) TearDownTest() {
+	errorsx.ClearGlobalTypeInferer()
+	for _, r := range errorsx.TypeInferers() {
+		if strings.HasPrefix(r.Name, "test.") {
+			s.Failf("leaked registration", "inferer %q was not unregistered", r.Name)
+		}
+	}
+}
+
+func prefixInferer(prefix string, typ errorsx.ErrorType) errorsx.ErrorTypeInferer {
+	return func(e *errorsx.Error) errorsx.ErrorType {
+		if strings.HasPrefix(e.ID(), prefix) {
+			return typ
+		}
+		return errorsx.TypeUnknown
+	}
+}
+
+func (s *TypeInfererRegistrySuite) TestLibrariesDoNotOverwriteEachOther() {
+	unregisterPayments := errorsx.RegisterTypeInferer("test.payments", 0, prefixInferer("payment.", "test.payment"))
+	defer unregisterPayments()
+	unregisterAuth := errorsx.RegisterTypeInferer("test.auth", 0, prefixInferer("auth.", "test.auth"))
+	defer unregisterAuth()
+
+	s.Equal(errorsx.ErrorType("test.payment"), errorsx.New("payment.declined").Type())
+	s.Equal(errorsx.ErrorType("test.auth"), errorsx.New("auth.failed").Type())
+	s.Equal(errorsx.TypeUnknown, errorsx.New("order.failed").Type())
+}
+
+func (s *TypeInfererRegistrySuite) TestPriorityOrder() {
+	defer errorsx.RegisterTypeInferer("test.low", -1, prefixInferer("db.", "test.low"))()
+	defer errorsx.RegisterTypeInferer("test.high", 10, prefixInferer("db.", "test.high"))()
+	defer errorsx.RegisterTypeInferer("test.b", 5, prefixInferer("db.", "test.b"))()
+	defer errorsx.RegisterTypeInferer("test.a", 5, prefixInferer("db.", "test.a"))()
+
+	var names []string
+	for _, r := range errorsx.TypeInferers() {
+		if strings.HasPrefix(r.Name, "test.") {
+			names = append(names, r.Name)
+		}
+	}
+	s.Equal([]string{"test.high", "test.a", "test.b", "test.low"}, names)
+	s.Equal(errorsx.ErrorType("test.high"), errorsx.New("db.failed").Type())
+}
+
+func (s *TypeInfererRegistrySuite) TestUnregister() {
+	err := errorsx.New("cache.miss")
+	unregister := errorsx.RegisterTypeInferer("test.cache", 0, prefixInferer("cache.", "test.cache"))
+	s.Equal(errorsx.ErrorType("test.cache"), err.Type())
+
+	unregister()
+	s.Equal(errorsx.TypeUnknown, err.Type())
+	unregister()
+}
+
+func (s *TypeInfererRegistrySuite) TestReRegistrationReplaces() {
+	unregisterOld := errorsx.RegisterTypeInferer("test.cache", 0, prefixInferer("cache.", "test.old"))
+	unregisterNew := errorsx.RegisterTypeInferer("test.cache", 0, prefixInferer("cache.", "test.new"))
+	defer unregisterNew()
+
+	s.Equal(errorsx.ErrorType("test.new"), errorsx.New("cache.miss").Type())
+
+	// The stale handle must not remove the newer registration.
+	unregisterOld()
+	s.Equal(errorsx.ErrorType("test.new"), errorsx.New("cache.miss").Type())
+}
+
+func (s *TypeInfererRegistrySuite) TestGlobalInfererShim() {
+	defer errorsx.RegisterTypeInferer("test.library", 0, prefixInferer("lib.", "test.library"))()
+
+	errorsx.SetGlobalTypeInferer(prefixInferer("app.", "test.app"))
+	s.Equal(errorsx.ErrorType("test.app"), errorsx.New("app.failed").Type())
+	s.Equal(errorsx.ErrorType("test.library"), errorsx.New("lib.failed").Type())
+
+	errorsx.SetGlobalTypeInferer(prefixInferer("app.", "test.replaced"))
+	s.Equal(errorsx.ErrorType("test.replaced"), errorsx.New("app.failed").Type())
+
+	errorsx.ClearGlobalTypeInferer()
+	s.Equal(errorsx.TypeUnknown, errorsx.New("app.failed").Type())
+	s.Equal(errorsx.ErrorType("test.library"), errorsx.New("lib.failed").Type())
+
+	errorsx.SetGlobalTypeInferer(prefixInferer("app.", "test.app"))
+	errorsx.SetGlobalTypeInferer(nil)
+	s.Equal(errorsx.TypeUnknown, errorsx.New("app.failed").Type())
+}
+
+func TestTypeInfererRegistrySuite(t *testing.T) {
+	suite.Run(t, new(TypeInfererRegistrySuite))
+}
@@ -0,0 +1,301 @@
+package errorsx
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MatchMode selects which rule wins when several rules match the same error ID.
+type MatchMode int
+
+const (
+	// FirstMatch selects the matching rule that appears first in the list.
+	FirstMatch MatchMode = iota
+
+	// MostSpecificMatch selects the most specific matching rule. A glob is more
+	// specific the more literal segments it has, then the more segments with
+	// '*' or '?' it has, then the fewer '**' segments, then the more literal
+	// characters. Regexp rules are less specific than any glob. Ties are broken
+	// by the order of the rules.
+	MostSpecificMatch
+)
+
+// Rule maps error IDs to an ErrorType.
+//
+// Pattern is a glob over the dot-separated segments of an ID:
+//   - '*' matches any characters within a single segment
+//   - '?' matches a single character within a segment
+//   - a '**' segment matches zero or more whole segments
+//
+// So "user.*" matches "user.login" but not "user.login.failed", while
+// "user.**" matches "user", "user.login" and "user.login.failed".
+//
+// If Regexp is set, it is matched against the whole ID instead of Pattern.
+type Rule struct {
+	Pattern string
+	Regexp  *regexp.Regexp
+	Type    ErrorType
+}
+
+// String returns the pattern of the rule, or its regexp between slashes.
+func (r Rule) String() string {
+	if r.Regexp != nil {
+		return "/" + r.Regexp.String() + "/"
+	}
+	return strconv.Quote(r.Pattern)
+}
+
+// Rules is an ordered list of rules.
+type Rules []Rule
+
+// RuleMatcher is a compiled list of rules. Glob patterns are compiled into a
+// trie of segments, so that matching an ID against many rules only visits
+// the patterns that share its segments.
+//
+// RuleMatcher is immutable and safe for concurrent use.
+type RuleMatcher struct {
+	rules   Rules
+	mode    MatchMode
+	root    *ruleNode
+	regexps []int
+	specs   []ruleSpecificity
+}
+
+// CompileRules compiles rules for matching with the given mode.
+//
+// Example:
+//
+//	matcher := errorsx.CompileRules(errorsx.Rules{
+//		{Pattern: "auth.**", Type: TypeAuthentication},
+//		{Pattern: "*.db.*", Type: TypeDatabase},
+//		{Regexp: regexp.MustCompile(`\.timeout$`), Type: errorsx.TypeTimeout},
+//	}, errorsx.MostSpecificMatch)
+//
+//	errorsx.SetGlobalTypeInferer(matcher.Infer)
+func CompileRules(rules Rules, mode MatchMode) *RuleMatcher {
+	m := &RuleMatcher{
+		rules: append(Rules(nil), rules...),
+		mode:  mode,
+		root:  &ruleNode{},
+		specs: make([]ruleSpecificity, len(rules)),
+	}
+	for i, rule := range m.rules {
+		if rule.Regexp != nil {
+			m.regexps = append(m.regexps, i)
+			m.specs[i] = ruleSpecificity{regexp: true}
+			continue
+		}
+		segments := strings.Split(rule.Pattern, ".")
+		m.root.insert(segments, i)
+		m.specs[i] = globSpecificity(segments)
+	}
+
+	return m
+}
+
+// RulesInferer creates an ErrorTypeInferer that classifies errors by matching
+// their IDs against the rules. Unlike IDPatternInferer, the result does not
+// depend on map iteration order.
+//
+// Example:
+//
+//	inferer := errorsx.RulesInferer(errorsx.Rules{
+//		{Pattern: "auth.session.*", Type: TypeSession},
+//		{Pattern: "auth.**", Type: TypeAuthentication},
+//	}, errorsx.FirstMatch)
+func RulesInferer(rules Rules, mode MatchMode) ErrorTypeInferer {
+	return CompileRules(rules, mode).Infer
+}
+
+// Rules returns a copy of the compiled rules.
+func (m *RuleMatcher) Rules() Rules {
+	return append(Rules(nil), m.rules...)
+}
+
+// Match returns the rule that wins for id.
+// Returns false if no rule matches.
+func (m *RuleMatcher) Match(id string) (Rule, bool) {
+	best := -1
+	consider := func(i int) {
+		if best < 0 || m.better(i, best) {
+			best = i
+		}
+	}
+
+	m.root.match(strings.Split(id, "."), 0, consider)
+	for _, i := range m.regexps {
+		if m.mode == FirstMatch && best >= 0 && best < i {
+			break
+		}
+		if m.rules[i].Regexp.MatchString(id) {
+			consider(i)
+		}
+	}
+
+	if best < 0 {
+		return Rule{}, false
+	}
+	return m.rules[best], true
+}
+
+// Infer is an ErrorTypeInferer that returns the type of the rule matching the error ID,
+// or TypeUnknown if no rule matches.
+func (m *RuleMatcher) Infer(e *Error) ErrorType {
+	if rule, ok := m.Match(e.ID()); ok {
+		e.recordRule("%s", rule)
+		return rule.Type
+	}
+	return TypeUnknown
+}
+
+// better reports whether rule i wins over rule j.
+func (m *RuleMatcher) better(i, j int) bool {
+	if m.mode == MostSpecificMatch && m.specs[i] != m.specs[j] {
+		return m.specs[i].moreSpecificThan(m.specs[j])
+	}
+	return i < j
+}
+
+// ruleNode is a node of the trie of glob segments.
+type ruleNode struct {
+	literals   map[string]*ruleNode
+	wildcards  []wildcardEdge
+	doubleStar *ruleNode
+	rules      []int
+}
+
+type wildcardEdge struct {
+	pattern string
+	node    *ruleNode
+}
+
+func (n *ruleNode) insert(segments []string, rule int) {
+	node := n
+	for _, segment := range segments {
+		node = node.child(segment)
+	}
+	node.rules = append(node.rules, rule)
+}
+
+func (n *ruleNode) child(segment string) *ruleNode {
+	switch {
+	case segment == "**":
+		if n.doubleStar == nil {
+			n.doubleStar = &ruleNode{}
+		}
+		return n.doubleStar
+	case strings.ContainsAny(segment, "*?"):
+		for _, edge := range n.wildcards {
+			if edge.pattern == segment {
+				return edge.node
+			}
+		}
+		child := &ruleNode{}
+		n.wildcards = append(n.wildcards, wildcardEdge{pattern: segment, node: child})
+		return child
+	default:
+		if n.literals == nil {
+			n.literals = make(map[string]*ruleNode)
+		}
+		child, ok := n.literals[segment]
+		if !ok {
+			child = &ruleNode{}
+			n.literals[segment] = child
+		}
+		return child
+	}
+}
+
+// match calls fn with every rule whose pattern matches segments[i:].
+// A rule may be reported more than once.
+func (n *ruleNode) match(segments []string, i int, fn func(rule int)) {
+	if n.doubleStar != nil {
+		for j := i; j <= len(segments); j++ {
+			n.doubleStar.match(segments, j, fn)
+		}
+	}
+	if i == len(segments) {
+		for _, rule := range n.rules {
+			fn(rule)
+		}
+		return
+	}
+	if child, ok := n.literals[segments[i]]; ok {
+		child.match(segments, i+1, fn)
+	}
+	for _, edge := range n.wildcards {
+		if matchSegment(edge.pattern, segments[i]) {
+			edge.node.match(segments, i+1, fn)
+		}
+	}
+}
+
+// matchSegment reports whether s matches pattern, where '*' matches any
+// sequence of characters and '?' matches a single character.
+func matchSegment(pattern, s string) bool {
+	p, t := []rune(pattern), []rune(s)
+	pi, ti := 0, 0
+	star, mark := -1, 0
+	for ti < len(t) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == t[ti]):
+			pi++
+			ti++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, ti
+			pi++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			ti = mark
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+// ruleSpecificity ranks rules for MostSpecificMatch.
+type ruleSpecificity struct {
+	regexp      bool
+	literals    int
+	wildcards   int
+	doubleStars int
+	chars       int
+}
+
+func globSpecificity(segments []string) ruleSpecificity {
+	var s ruleSpecificity
+	for _, segment := range segments {
+		switch {
+		case segment == "**":
+			s.doubleStars++
+		case strings.ContainsAny(segment, "*?"):
+			s.wildcards++
+			s.chars += len(segment) - strings.Count(segment, "*") - strings.Count(segment, "?")
+		default:
+			s.literals++
+			s.chars += len(segment)
+		}
+	}
+	return s
+}
+
+func (s ruleSpecificity) moreSpecificThan(o ruleSpecificity) bool {
+	switch {
+	case s.regexp != o.regexp:
+		return !s.regexp
+	case s.literals != o.literals:
+		return s.literals > o.literals
+	case s.wildcards != o.wildcards:
+		return s.wildcards > o.wildcards
+	case s.doubleStars != o.doubleStars:
+		return s.doubleStars < o.doubleStars
+	default:
+		return s.chars > o.chars
+	}
+}
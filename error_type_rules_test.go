@@ -0,0 +1,147 @@
+package errorsx_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type RulesSuite struct {
+	suite.Suite
+}
+
+func (s *RulesSuite) TestSegmentGlob() {
+	tests := []struct {
+		pattern string
+		id      string
+		want    bool
+	}{
+		{"user.login", "user.login", true},
+		{"user.login", "user.logout", false},
+		{"user.*", "user.login", true},
+		{"user.*", "user.login.failed", false},
+		{"user.*", "user", false},
+		{"user.**", "user", true},
+		{"user.**", "user.login.failed", true},
+		{"user.**", "users.login", false},
+		{"**.failed", "user.login.failed", true},
+		{"**.failed", "failed", true},
+		{"user.**.failed", "user.failed", true},
+		{"user.**.failed", "user.a.b.failed", true},
+		{"user.**.failed", "user.a.b.timeout", false},
+		{"user.log*", "user.login", true},
+		{"user.log?n", "user.login", true},
+		{"user.log?n", "user.logn", false},
+		{"*.db.*", "order.db.timeout", true},
+		{"**", "anything.at.all", true},
+		{"db.*_timeout", "db.read_timeout", true},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.pattern+" "+tt.id, func() {
+			m := errorsx.CompileRules(errorsx.Rules{{Pattern: tt.pattern, Type: "test.match"}}, errorsx.FirstMatch)
+			_, ok := m.Match(tt.id)
+			s.Equal(tt.want, ok)
+		})
+	}
+}
+
+func (s *RulesSuite) TestFirstMatch() {
+	rules := errorsx.Rules{
+		{Pattern: "auth.**", Type: "test.auth"},
+		{Pattern: "auth.session.*", Type: "test.session"},
+		{Regexp: regexp.MustCompile(`expired$`), Type: "test.expired"},
+	}
+	inferer := errorsx.RulesInferer(rules, errorsx.FirstMatch)
+
+	s.Equal(errorsx.ErrorType("test.auth"), inferer(errorsx.New("auth.session.expired")))
+	s.Equal(errorsx.ErrorType("test.expired"), inferer(errorsx.New("token.expired")))
+	s.Equal(errorsx.TypeUnknown, inferer(errorsx.New("order.failed")))
+}
+
+func (s *RulesSuite) TestMostSpecificMatch() {
+	rules := errorsx.Rules{
+		{Regexp: regexp.MustCompile(`^auth\.`), Type: "test.regexp"},
+		{Pattern: "**", Type: "test.any"},
+		{Pattern: "auth.**", Type: "test.auth"},
+		{Pattern: "auth.*.*", Type: "test.auth_two"},
+		{Pattern: "auth.session.*", Type: "test.session"},
+		{Pattern: "auth.session.expired", Type: "test.expired"},
+	}
+	m := errorsx.CompileRules(rules, errorsx.MostSpecificMatch)
+
+	tests := []struct {
+		id   string
+		want errorsx.ErrorType
+	}{
+		{"auth.session.expired", "test.expired"},
+		{"auth.session.revoked", "test.session"},
+		{"auth.token.revoked", "test.auth_two"},
+		{"auth.failed", "test.auth"},
+		{"order.failed", "test.any"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.id, func() {
+			rule, ok := m.Match(tt.id)
+			s.True(ok)
+			s.Equal(tt.want, rule.Type)
+		})
+	}
+}
+
+func (s *RulesSuite) TestRegexpLeastSpecific() {
+	m := errorsx.CompileRules(errorsx.Rules{
+		{Regexp: regexp.MustCompile(`^auth\.failed$`), Type: "test.regexp"},
+		{Pattern: "auth.**", Type: "test.glob"},
+	}, errorsx.MostSpecificMatch)
+
+	rule, ok := m.Match("auth.failed")
+	s.True(ok)
+	s.Equal(errorsx.ErrorType("test.glob"), rule.Type)
+}
+
+func (s *RulesSuite) TestTiesKeepOrder() {
+	m := errorsx.CompileRules(errorsx.Rules{
+		{Pattern: "user.*", Type: "test.first"},
+		{Pattern: "*.user", Type: "test.second"},
+	}, errorsx.MostSpecificMatch)
+
+	rule, ok := m.Match("user.user")
+	s.True(ok)
+	s.Equal(errorsx.ErrorType("test.first"), rule.Type)
+}
+
+func (s *RulesSuite) TestRulesAreCopied() {
+	rules := errorsx.Rules{{Pattern: "user.*", Type: "test.user"}}
+	m := errorsx.CompileRules(rules, errorsx.FirstMatch)
+	rules[0].Type = "test.changed"
+
+	s.Equal(errorsx.ErrorType("test.user"), m.Rules()[0].Type)
+	s.Equal(errorsx.ErrorType("test.user"), m.Infer(errorsx.New("user.login")))
+}
+
+func (s *RulesSuite) TestMapInferersAreDeterministic() {
+	patterns := map[string]errorsx.ErrorType{
+		"*":          "test.any",
+		"user.*":     "test.user",
+		"user.login": "test.login",
+		"*.login":    "test.suffix",
+	}
+	substrings := map[string]errorsx.ErrorType{
+		"user":       "test.user",
+		"user.login": "test.login",
+		"login":      "test.suffix",
+	}
+
+	for i := 0; i < 20; i++ {
+		err := errorsx.New("user.login")
+		s.Equal(errorsx.ErrorType("test.login"), errorsx.IDPatternInferer(patterns)(err))
+		s.Equal(errorsx.ErrorType("test.login"), errorsx.IDContainsInferer(substrings)(err))
+	}
+}
+
+func TestRulesSuite(t *testing.T) {
+	suite.Run(t, new(RulesSuite))
+}
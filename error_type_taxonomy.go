@@ -0,0 +1,302 @@
+package errorsx
+
+import "log/slog"
+
+// Standard error types modeled on the gRPC canonical status codes.
+// Each type has a default HTTP status, gRPC code, retryability, severity,
+// exit code and log level (see LookupTypeInfo), and sits under
+// TypeClientError or TypeServerError in the error type hierarchy.
+const (
+	// TypeUnauthenticated represents requests without valid authentication credentials.
+	// HTTP 401, gRPC Unauthenticated.
+	TypeUnauthenticated ErrorType = "errorsx.unauthenticated"
+
+	// TypePermissionDenied represents authenticated callers that lack permission for an operation.
+	// HTTP 403, gRPC PermissionDenied.
+	TypePermissionDenied ErrorType = "errorsx.permission_denied"
+
+	// TypeConflict represents operations that conflict with the current state of a resource.
+	// HTTP 409, gRPC Aborted.
+	TypeConflict ErrorType = "errorsx.conflict"
+
+	// TypeAlreadyExists represents attempts to create a resource that already exists.
+	// It is a child of TypeConflict. HTTP 409, gRPC AlreadyExists.
+	TypeAlreadyExists ErrorType = "errorsx.already_exists"
+
+	// TypePreconditionFailed represents operations rejected because the system is not in the required state.
+	// HTTP 412, gRPC FailedPrecondition.
+	TypePreconditionFailed ErrorType = "errorsx.precondition_failed"
+
+	// TypeRateLimited represents callers that exceeded a rate limit. Retryable.
+	// HTTP 429, gRPC ResourceExhausted.
+	TypeRateLimited ErrorType = "errorsx.rate_limited"
+
+	// TypeTimeout represents operations that did not complete before their deadline. Retryable.
+	// HTTP 504, gRPC DeadlineExceeded.
+	TypeTimeout ErrorType = "errorsx.timeout"
+
+	// TypeUnavailable represents services that are temporarily unavailable. Retryable.
+	// HTTP 503, gRPC Unavailable.
+	TypeUnavailable ErrorType = "errorsx.unavailable"
+
+	// TypeInternal represents broken invariants and other internal failures.
+	// HTTP 500, gRPC Internal.
+	TypeInternal ErrorType = "errorsx.internal"
+
+	// TypeNotImplemented represents operations that are not implemented or supported.
+	// HTTP 501, gRPC Unimplemented.
+	TypeNotImplemented ErrorType = "errorsx.not_implemented"
+
+	// TypeCanceled represents operations canceled by the caller.
+	// HTTP 499 (Client Closed Request), gRPC Canceled.
+	TypeCanceled ErrorType = "errorsx.canceled"
+
+	// TypeResourceExhausted represents exhausted system resources such as quotas or storage. Retryable.
+	// HTTP 503, gRPC ResourceExhausted.
+	TypeResourceExhausted ErrorType = "errorsx.resource_exhausted"
+
+	// TypeDataLoss represents unrecoverable data loss or corruption.
+	// HTTP 500, gRPC DataLoss.
+	TypeDataLoss ErrorType = "errorsx.data_loss"
+)
+
+// gRPC canonical status codes, with the values of google.golang.org/grpc/codes.
+const (
+	grpcCanceled           uint32 = 1
+	grpcUnknown            uint32 = 2
+	grpcInvalidArgument    uint32 = 3
+	grpcDeadlineExceeded   uint32 = 4
+	grpcNotFound           uint32 = 5
+	grpcAlreadyExists      uint32 = 6
+	grpcPermissionDenied   uint32 = 7
+	grpcResourceExhausted  uint32 = 8
+	grpcFailedPrecondition uint32 = 9
+	grpcAborted            uint32 = 10
+	grpcUnimplemented      uint32 = 12
+	grpcInternal           uint32 = 13
+	grpcUnavailable        uint32 = 14
+	grpcDataLoss           uint32 = 15
+	grpcUnauthenticated    uint32 = 16
+)
+
+// HTTP statuses used by the default type metadata.
+const (
+	badRequestHTTPStatus          = 400
+	unauthorizedHTTPStatus        = 401
+	forbiddenHTTPStatus           = 403
+	notFoundHTTPStatus            = 404
+	conflictHTTPStatus            = 409
+	preconditionFailedHTTPStatus  = 412
+	tooManyRequestsHTTPStatus     = 429
+	clientClosedRequestHTTPStatus = 499
+	serverErrorHTTPStatus         = 500
+	notImplementedHTTPStatus      = 501
+	unavailableHTTPStatus         = 503
+	gatewayTimeoutHTTPStatus      = 504
+)
+
+// Exit codes used by the default type metadata, following the BSD sysexits convention.
+const (
+	exitCodeFailure     = 1
+	exitCodeDataErr     = 65
+	exitCodeNoInput     = 66
+	exitCodeUnavailable = 69
+	exitCodeSoftware    = 70
+	exitCodeIOErr       = 74
+	exitCodeTempFail    = 75
+	exitCodeNoPerm      = 77
+	exitCodeConfig      = 78
+)
+
+// builtinType describes the position and metadata of a built-in ErrorType.
+type builtinType struct {
+	typ    ErrorType
+	parent ErrorType
+	info   TypeInfo
+}
+
+// builtinTypes lists the default error type hierarchy and metadata.
+//
+//nolint:gochecknoglobals
+var builtinTypes = []builtinType{
+	{TypeClientError, "", TypeInfo{
+		HTTPStatus: badRequestHTTPStatus, GRPCCode: grpcInvalidArgument,
+		Severity: SeverityWarning, ExitCode: exitCodeDataErr, LogLevel: slog.LevelWarn,
+	}},
+	{TypeServerError, "", TypeInfo{
+		HTTPStatus: serverErrorHTTPStatus, GRPCCode: grpcInternal,
+		Severity: SeverityError, ExitCode: exitCodeSoftware, LogLevel: slog.LevelError,
+	}},
+	// Untyped errors keep HTTPStatus 0 so that callers can apply their own default.
+	{TypeUnknown, TypeServerError, TypeInfo{
+		GRPCCode: grpcUnknown, Severity: SeverityError, ExitCode: exitCodeFailure, LogLevel: slog.LevelError,
+	}},
+	{TypeInitialization, TypeServerError, TypeInfo{
+		HTTPStatus: serverErrorHTTPStatus, GRPCCode: grpcInternal,
+		Severity: SeverityCritical, ExitCode: exitCodeConfig, LogLevel: slog.LevelError,
+	}},
+	{TypeValidation, TypeClientError, TypeInfo{
+		HTTPStatus: badRequestHTTPStatus, GRPCCode: grpcInvalidArgument,
+		Severity: SeverityInfo, ExitCode: exitCodeDataErr, LogLevel: slog.LevelInfo,
+	}},
+	{TypeNotFound, TypeClientError, TypeInfo{
+		HTTPStatus: notFoundHTTPStatus, GRPCCode: grpcNotFound,
+		Severity: SeverityInfo, ExitCode: exitCodeNoInput, LogLevel: slog.LevelInfo,
+	}},
+	{TypeUnauthenticated, TypeClientError, TypeInfo{
+		HTTPStatus: unauthorizedHTTPStatus, GRPCCode: grpcUnauthenticated,
+		Severity: SeverityWarning, ExitCode: exitCodeNoPerm, LogLevel: slog.LevelWarn,
+	}},
+	{TypePermissionDenied, TypeClientError, TypeInfo{
+		HTTPStatus: forbiddenHTTPStatus, GRPCCode: grpcPermissionDenied,
+		Severity: SeverityWarning, ExitCode: exitCodeNoPerm, LogLevel: slog.LevelWarn,
+	}},
+	{TypeConflict, TypeClientError, TypeInfo{
+		HTTPStatus: conflictHTTPStatus, GRPCCode: grpcAborted,
+		Severity: SeverityInfo, ExitCode: exitCodeDataErr, LogLevel: slog.LevelInfo,
+	}},
+	{TypeAlreadyExists, TypeConflict, TypeInfo{
+		HTTPStatus: conflictHTTPStatus, GRPCCode: grpcAlreadyExists,
+		Severity: SeverityInfo, ExitCode: exitCodeDataErr, LogLevel: slog.LevelInfo,
+	}},
+	{TypePreconditionFailed, TypeClientError, TypeInfo{
+		HTTPStatus: preconditionFailedHTTPStatus, GRPCCode: grpcFailedPrecondition,
+		Severity: SeverityInfo, ExitCode: exitCodeDataErr, LogLevel: slog.LevelInfo,
+	}},
+	{TypeRateLimited, TypeClientError, TypeInfo{
+		HTTPStatus: tooManyRequestsHTTPStatus, GRPCCode: grpcResourceExhausted, Retryable: true,
+		Severity: SeverityWarning, ExitCode: exitCodeTempFail, LogLevel: slog.LevelWarn,
+	}},
+	{TypeCanceled, TypeClientError, TypeInfo{
+		HTTPStatus: clientClosedRequestHTTPStatus, GRPCCode: grpcCanceled,
+		Severity: SeverityInfo, ExitCode: exitCodeFailure, LogLevel: slog.LevelInfo,
+	}},
+	{TypeTimeout, TypeServerError, TypeInfo{
+		HTTPStatus: gatewayTimeoutHTTPStatus, GRPCCode: grpcDeadlineExceeded, Retryable: true,
+		Severity: SeverityWarning, ExitCode: exitCodeTempFail, LogLevel: slog.LevelWarn,
+	}},
+	{TypeUnavailable, TypeServerError, TypeInfo{
+		HTTPStatus: unavailableHTTPStatus, GRPCCode: grpcUnavailable, Retryable: true,
+		Severity: SeverityError, ExitCode: exitCodeUnavailable, LogLevel: slog.LevelError,
+	}},
+	{TypeInternal, TypeServerError, TypeInfo{
+		HTTPStatus: serverErrorHTTPStatus, GRPCCode: grpcInternal,
+		Severity: SeverityError, ExitCode: exitCodeSoftware, LogLevel: slog.LevelError,
+	}},
+	{TypeNotImplemented, TypeServerError, TypeInfo{
+		HTTPStatus: notImplementedHTTPStatus, GRPCCode: grpcUnimplemented,
+		Severity: SeverityError, ExitCode: exitCodeSoftware, LogLevel: slog.LevelError,
+	}},
+	{TypeResourceExhausted, TypeServerError, TypeInfo{
+		HTTPStatus: unavailableHTTPStatus, GRPCCode: grpcResourceExhausted, Retryable: true,
+		Severity: SeverityError, ExitCode: exitCodeTempFail, LogLevel: slog.LevelError,
+	}},
+	{TypeDataLoss, TypeServerError, TypeInfo{
+		HTTPStatus: serverErrorHTTPStatus, GRPCCode: grpcDataLoss,
+		Severity: SeverityCritical, ExitCode: exitCodeIOErr, LogLevel: slog.LevelError,
+	}},
+}
+
+func defaultTypeParents() map[ErrorType]ErrorType {
+	parents := make(map[ErrorType]ErrorType, len(builtinTypes))
+	for _, b := range builtinTypes {
+		if b.parent != "" {
+			parents[b.typ] = b.parent
+		}
+	}
+	return parents
+}
+
+func defaultTypeInfos() map[ErrorType]TypeInfo {
+	infos := make(map[ErrorType]TypeInfo, len(builtinTypes))
+	for _, b := range builtinTypes {
+		infos[b.typ] = b.info
+	}
+	return infos
+}
+
+// NewUnauthenticated creates a new error of TypeUnauthenticated with the given ID.
+//
+// Example:
+//
+//	err := errorsx.NewUnauthenticated("auth.token_expired")
+//	// err.HTTPStatus() == 401
+func NewUnauthenticated(id string, opts ...Option) *Error {
+	return newTyped(id, TypeUnauthenticated, opts)
+}
+
+// NewPermissionDenied creates a new error of TypePermissionDenied with the given ID.
+func NewPermissionDenied(id string, opts ...Option) *Error {
+	return newTyped(id, TypePermissionDenied, opts)
+}
+
+// NewConflict creates a new error of TypeConflict with the given ID.
+//
+// Example:
+//
+//	err := errorsx.NewConflict("order.version_mismatch")
+//	// err.HTTPStatus() == 409
+func NewConflict(id string, opts ...Option) *Error {
+	return newTyped(id, TypeConflict, opts)
+}
+
+// NewAlreadyExists creates a new error of TypeAlreadyExists with the given ID.
+func NewAlreadyExists(id string, opts ...Option) *Error {
+	return newTyped(id, TypeAlreadyExists, opts)
+}
+
+// NewPreconditionFailed creates a new error of TypePreconditionFailed with the given ID.
+func NewPreconditionFailed(id string, opts ...Option) *Error {
+	return newTyped(id, TypePreconditionFailed, opts)
+}
+
+// NewRateLimited creates a new retryable error of TypeRateLimited with the given ID.
+//
+// Example:
+//
+//	err := errorsx.NewRateLimited("api.rate_limited", errorsx.WithRetryAfter(time.Minute))
+//	// err.HTTPStatus() == 429, err.IsRetryable() == true
+func NewRateLimited(id string, opts ...Option) *Error {
+	return newTyped(id, TypeRateLimited, opts)
+}
+
+// NewTimeout creates a new retryable error of TypeTimeout with the given ID.
+func NewTimeout(id string, opts ...Option) *Error {
+	return newTyped(id, TypeTimeout, opts)
+}
+
+// NewUnavailable creates a new retryable error of TypeUnavailable with the given ID.
+func NewUnavailable(id string, opts ...Option) *Error {
+	return newTyped(id, TypeUnavailable, opts)
+}
+
+// NewInternal creates a new error of TypeInternal with the given ID.
+func NewInternal(id string, opts ...Option) *Error {
+	return newTyped(id, TypeInternal, opts)
+}
+
+// NewNotImplemented creates a new error of TypeNotImplemented with the given ID.
+func NewNotImplemented(id string, opts ...Option) *Error {
+	return newTyped(id, TypeNotImplemented, opts)
+}
+
+// NewCanceled creates a new error of TypeCanceled with the given ID.
+func NewCanceled(id string, opts ...Option) *Error {
+	return newTyped(id, TypeCanceled, opts)
+}
+
+// NewResourceExhausted creates a new retryable error of TypeResourceExhausted with the given ID.
+func NewResourceExhausted(id string, opts ...Option) *Error {
+	return newTyped(id, TypeResourceExhausted, opts)
+}
+
+// NewDataLoss creates a new error of TypeDataLoss with the given ID.
+func NewDataLoss(id string, opts ...Option) *Error {
+	return newTyped(id, TypeDataLoss, opts)
+}
+
+// newTyped creates a new error of typ. The options are applied after the
+// type, so they can still override it.
+func newTyped(id string, typ ErrorType, opts []Option) *Error {
+	return New(id, append([]Option{WithType(typ)}, opts...)...)
+}
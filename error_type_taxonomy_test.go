@@ -0,0 +1,89 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxonomySuite struct {
+	suite.Suite
+}
+
+func (s *TaxonomySuite) TestConstructors() {
+	tests := []struct {
+		name      string
+		err       *errorsx.Error
+		typ       errorsx.ErrorType
+		status    int
+		grpcCode  uint32
+		retryable bool
+		parent    errorsx.ErrorType
+	}{
+		{"Unauthenticated", errorsx.NewUnauthenticated("auth.token_expired"), errorsx.TypeUnauthenticated, 401, 16, false, errorsx.TypeClientError},
+		{"PermissionDenied", errorsx.NewPermissionDenied("auth.forbidden"), errorsx.TypePermissionDenied, 403, 7, false, errorsx.TypeClientError},
+		{"Conflict", errorsx.NewConflict("order.version_mismatch"), errorsx.TypeConflict, 409, 10, false, errorsx.TypeClientError},
+		{"AlreadyExists", errorsx.NewAlreadyExists("user.email_taken"), errorsx.TypeAlreadyExists, 409, 6, false, errorsx.TypeConflict},
+		{"PreconditionFailed", errorsx.NewPreconditionFailed("order.not_paid"), errorsx.TypePreconditionFailed, 412, 9, false, errorsx.TypeClientError},
+		{"RateLimited", errorsx.NewRateLimited("api.rate_limited"), errorsx.TypeRateLimited, 429, 8, true, errorsx.TypeClientError},
+		{"Canceled", errorsx.NewCanceled("request.canceled"), errorsx.TypeCanceled, 499, 1, false, errorsx.TypeClientError},
+		{"Timeout", errorsx.NewTimeout("db.timeout"), errorsx.TypeTimeout, 504, 4, true, errorsx.TypeServerError},
+		{"Unavailable", errorsx.NewUnavailable("db.unavailable"), errorsx.TypeUnavailable, 503, 14, true, errorsx.TypeServerError},
+		{"Internal", errorsx.NewInternal("invariant.broken"), errorsx.TypeInternal, 500, 13, false, errorsx.TypeServerError},
+		{"NotImplemented", errorsx.NewNotImplemented("export.pdf"), errorsx.TypeNotImplemented, 501, 12, false, errorsx.TypeServerError},
+		{"ResourceExhausted", errorsx.NewResourceExhausted("disk.full"), errorsx.TypeResourceExhausted, 503, 8, true, errorsx.TypeServerError},
+		{"DataLoss", errorsx.NewDataLoss("storage.corrupted"), errorsx.TypeDataLoss, 500, 15, false, errorsx.TypeServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.typ, tt.err.Type())
+			s.Equal(tt.status, tt.err.HTTPStatus())
+			s.Equal(tt.grpcCode, tt.err.GRPCCode())
+			s.Equal(tt.retryable, tt.err.IsRetryable())
+
+			parent, ok := errorsx.TypeParent(tt.typ)
+			s.True(ok)
+			s.Equal(tt.parent, parent)
+		})
+	}
+}
+
+func (s *TaxonomySuite) TestConstructorOptions() {
+	err := errorsx.NewConflict("order.version_mismatch", errorsx.WithHTTPStatus(422), errorsx.WithRetryable())
+	s.Equal(errorsx.TypeConflict, err.Type())
+	s.Equal(422, err.HTTPStatus())
+	s.True(err.IsRetryable())
+}
+
+func (s *TaxonomySuite) TestHierarchy() {
+	err := fmt.Errorf("create user: %w", errorsx.NewAlreadyExists("user.email_taken"))
+	s.True(errorsx.HasType(err, errorsx.TypeConflict))
+	s.True(errorsx.HasType(err, errorsx.TypeClientError))
+	s.False(errorsx.HasType(err, errorsx.TypeServerError))
+}
+
+func (s *TaxonomySuite) TestGRPCCodeOf() {
+	s.Equal(uint32(0), errorsx.GRPCCodeOf(nil))
+	s.Equal(uint32(2), errorsx.GRPCCodeOf(errors.New("plain")))
+	s.Equal(uint32(3), errorsx.GRPCCodeOf(errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation))))
+	s.Equal(uint32(5), errorsx.GRPCCodeOf(fmt.Errorf("wrapped: %w", errorsx.New("user.missing", errorsx.WithNotFound()))))
+}
+
+func (s *TaxonomySuite) TestCircuitOpenIsUnavailable() {
+	breaker := errorsx.NewBreaker("test", errorsx.WithBreakerThreshold(1))
+	ticket, _ := breaker.Allow()
+	breaker.Record(ticket, errors.New("boom"))
+
+	_, err := breaker.Allow()
+	s.True(errorsx.HasType(err, errorsx.TypeUnavailable))
+	s.Equal(503, errorsx.HTTPStatus(err))
+	s.True(errorsx.IsRetryable(err))
+}
+
+func TestTaxonomySuite(t *testing.T) {
+	suite.Run(t, new(TaxonomySuite))
+}
@@ -119,6 +119,18 @@ func (suite *ErrorTypeTestSuite) TestFilterByType() {
 				return errors.Join(joined1, err3)
 			},
 			typ:      errorsx.ErrorType("test"),
+			expected: 3,
+		},
+		{
+			name: "Intermediate error behind plain wrapper",
+			setup: func() error {
+				inner := errorsx.New("inner.error").WithType(errorsx.ErrorType("test"))
+				middle := errorsx.New("middle.error").
+					WithType(errorsx.ErrorType("test")).
+					WithCause(fmt.Errorf("wrapped: %w", inner))
+				return fmt.Errorf("outer: %w", middle)
+			},
+			typ:      errorsx.ErrorType("test"),
 			expected: 2,
 		},
 	}
@@ -268,3 +280,57 @@ func (suite *ErrorTypeTestSuite) TestHasType() {
 		})
 	}
 }
+
+func (suite *ErrorTypeTestSuite) TestTypeHierarchy() {
+	const (
+		typeEmailInvalid  errorsx.ErrorType = "test.email_invalid"
+		typeDomainInvalid errorsx.ErrorType = "test.email_domain_invalid"
+	)
+	errorsx.RegisterType(typeEmailInvalid, errorsx.TypeValidation)
+	errorsx.RegisterType(typeDomainInvalid, typeEmailInvalid)
+	defer errorsx.RegisterType(typeEmailInvalid, "")
+	defer errorsx.RegisterType(typeDomainInvalid, "")
+
+	suite.Equal(
+		[]errorsx.ErrorType{typeEmailInvalid, errorsx.TypeValidation, errorsx.TypeClientError},
+		errorsx.TypeAncestors(typeDomainInvalid),
+	)
+	parent, ok := errorsx.TypeParent(typeEmailInvalid)
+	suite.True(ok)
+	suite.Equal(errorsx.TypeValidation, parent)
+
+	err := fmt.Errorf("wrapped: %w", errorsx.New("user.email_invalid", errorsx.WithType(typeDomainInvalid)))
+	suite.True(errorsx.HasType(err, typeDomainInvalid))
+	suite.True(errorsx.HasType(err, typeEmailInvalid))
+	suite.True(errorsx.HasType(err, errorsx.TypeValidation))
+	suite.True(errorsx.HasType(err, errorsx.TypeClientError))
+	suite.False(errorsx.HasType(err, errorsx.TypeNotFound))
+	suite.False(errorsx.HasType(err, errorsx.TypeServerError))
+	suite.Len(errorsx.FilterByType(err, errorsx.TypeValidation), 1)
+	suite.True(errorsx.Match(err, errorsx.ByType(errorsx.TypeClientError)))
+
+	// A parent does not match its children
+	parentErr := errorsx.New("user.invalid", errorsx.WithType(errorsx.TypeValidation))
+	suite.False(errorsx.HasType(parentErr, typeEmailInvalid))
+}
+
+func (suite *ErrorTypeTestSuite) TestDefaultTypeHierarchy() {
+	suite.True(errorsx.IsTypeOf(errorsx.TypeValidation, errorsx.TypeClientError))
+	suite.True(errorsx.IsTypeOf(errorsx.TypeNotFound, errorsx.TypeClientError))
+	suite.True(errorsx.IsTypeOf(errorsx.TypeInitialization, errorsx.TypeServerError))
+	suite.True(errorsx.IsTypeOf(errorsx.TypeUnknown, errorsx.TypeServerError))
+	suite.False(errorsx.IsTypeOf(errorsx.TypeClientError, errorsx.TypeValidation))
+	suite.Empty(errorsx.TypeAncestors(errorsx.TypeClientError))
+}
+
+func (suite *ErrorTypeTestSuite) TestRegisterTypeRejectsCycles() {
+	const (
+		typeA errorsx.ErrorType = "test.cycle_a"
+		typeB errorsx.ErrorType = "test.cycle_b"
+	)
+	errorsx.RegisterType(typeA, typeB)
+	defer errorsx.RegisterType(typeA, "")
+
+	suite.Panics(func() { errorsx.RegisterType(typeB, typeA) })
+	suite.Panics(func() { errorsx.RegisterType(typeA, typeA) })
+}
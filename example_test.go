@@ -26,7 +26,7 @@ func Example() {
 
 	// Output:
 	// Error ID: user.not_found
-	// Error Type: errorsx.unknown
+	// Error Type: errorsx.not_found
 	// Error Message: user.not_found
 }
 
@@ -0,0 +1,188 @@
+package errorsx
+
+import (
+	"regexp"
+	"sync/atomic"
+)
+
+// Config holds the policies applied to the errors created by a Factory.
+// The zero Config reproduces the package defaults.
+type Config struct {
+	// IDPrefix is prepended to the ID of every created error, separated by a dot.
+	IDPrefix string
+
+	// TypeInferers are consulted in order after the instance inferer and
+	// before the inferers registered with RegisterTypeInferer.
+	TypeInferers []ErrorTypeInferer
+
+	// StackTraceCleaner is the stack trace cleaner of created errors.
+	// WithStackTraceCleaner replaces it for a single error.
+	StackTraceCleaner StackTraceCleaner
+
+	// MaxStackFrames is the maximum number of frames captured by WithStack
+	// and WithCause. Zero means MaxStackFrames.
+	MaxStackFrames int
+
+	// StackCapture selects how much of the stack created errors capture.
+	// The default is StackCaptureFull.
+	StackCapture StackCaptureMode
+
+	// PanicHook is notified when an extension point of a created error panics,
+	// instead of the hook set with SetPanicHook.
+	PanicHook PanicHook
+
+	// DeprecationHook is notified when an error is created with a deprecated
+	// ID, instead of the hook set with SetDeprecationHook.
+	DeprecationHook DeprecationHook
+
+	// IDValidation selects how IDs that violate IDPattern are handled.
+	// The default, IDValidationOff, accepts any ID.
+	IDValidation IDValidationMode
+
+	// IDPattern is the grammar of valid IDs, including the IDPrefix.
+	// Nil means DefaultIDPattern.
+	IDPattern *regexp.Regexp
+
+	// IDViolationHook is notified of invalid IDs in IDValidationReport mode.
+	// Without a hook, violations are logged with slog.Warn.
+	IDViolationHook IDViolationHook
+
+	// Options are applied to every created error before the options passed to New.
+	Options []Option
+}
+
+// Factory creates errors that share a Config. Errors remember the factory
+// that created them, so different subsystems of one binary can apply
+// different policies without touching the package-level settings.
+//
+// A Factory is immutable and safe for concurrent use.
+//
+// Example:
+//
+//	var billingErrors = errorsx.NewFactory(errorsx.Config{
+//		IDPrefix:     "billing",
+//		TypeInferers: []errorsx.ErrorTypeInferer{errorsx.StdlibInferer()},
+//		Options:      []errorsx.Option{errorsx.WithHTTPStatus(500)},
+//	})
+//
+//	err := billingErrors.New("invoice.not_found") // ID "billing.invoice.not_found"
+type Factory struct {
+	config Config
+}
+
+// NewFactory returns a Factory that applies config to the errors it creates.
+func NewFactory(config Config) *Factory {
+	config.TypeInferers = append([]ErrorTypeInferer(nil), config.TypeInferers...)
+	config.Options = append([]Option(nil), config.Options...)
+	if config.MaxStackFrames <= 0 {
+		config.MaxStackFrames = MaxStackFrames
+	}
+	return &Factory{config: config}
+}
+
+// builtinFactory is the default factory unless SetDefaultFactory replaced it.
+var builtinFactory = NewFactory(Config{}) //nolint:gochecknoglobals
+
+// defaultFactory is the factory set with SetDefaultFactory.
+var defaultFactory atomic.Pointer[Factory] //nolint:gochecknoglobals
+
+// DefaultFactory returns the factory used by New and the other package-level constructors.
+func DefaultFactory() *Factory {
+	if f := defaultFactory.Load(); f != nil {
+		return f
+	}
+	return builtinFactory
+}
+
+// SetDefaultFactory sets the factory used by New and the other package-level
+// constructors. Errors created before the call keep their factory.
+// A nil factory restores the built-in default.
+func SetDefaultFactory(f *Factory) {
+	defaultFactory.Store(f)
+}
+
+// Config returns a copy of the configuration of the factory.
+func (f *Factory) Config() Config {
+	config := f.config
+	config.TypeInferers = append([]ErrorTypeInferer(nil), config.TypeInferers...)
+	config.Options = append([]Option(nil), config.Options...)
+	return config
+}
+
+// New creates a new Error with the given id and options, applying the
+// configuration of the factory. See the package-level New.
+func (f *Factory) New(id string, opts ...Option) *Error {
+	if f.config.IDPrefix != "" {
+		id = f.config.IDPrefix + "." + id
+	}
+	f.validateID(id)
+
+	e := &Error{
+		id:                id,
+		msg:               id,
+		errType:           TypeUnknown,
+		stackTraceCleaner: f.config.StackTraceCleaner,
+		factory:           f,
+		maxStackFrames:    f.config.MaxStackFrames,
+		stackCapture:      f.config.StackCapture,
+		typeCache:         &typeCache{},
+	}
+	for _, opt := range f.config.Options {
+		opt(e)
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.reportDeprecatedID()
+
+	return e
+}
+
+// newInternal creates an error with one of the IDs defined by this package.
+// The built-in factory keeps the ID free of the prefix, validation and
+// options of the default factory, so that it still matches the exported ID
+// constants and sentinels, while the stack capture settings of the default
+// factory still apply.
+func newInternal(id string, opts ...Option) *Error {
+	e := builtinFactory.New(id, opts...)
+	config := DefaultFactory().config
+	e.maxStackFrames = config.MaxStackFrames
+	e.stackCapture = config.StackCapture
+	return e
+}
+
+// NewNotFound creates a new "not found" error with the given ID.
+// See the package-level NewNotFound.
+func (f *Factory) NewNotFound(idOrMsg string) *Error {
+	return f.New(idOrMsg).WithNotFound()
+}
+
+// NewRetryable creates a new retryable error with the given ID.
+// See the package-level NewRetryable.
+func (f *Factory) NewRetryable(idOrMsg string) *Error {
+	return f.New(idOrMsg).WithRetryable()
+}
+
+// NewValidationError creates a new validation error with the given ID.
+// See the package-level NewValidationError.
+func (f *Factory) NewValidationError(id string) *ValidationError {
+	return &ValidationError{
+		BaseError:         f.New(id, WithType(TypeValidation)),
+		FieldErrors:       nil,
+		summaryTranslator: DefaultSummaryTranslator,
+		fieldTranslator:   DefaultFieldTranslator,
+	}
+}
+
+// Factory returns the factory that created the error.
+func (e *Error) Factory() *Factory {
+	if e == nil || e.factory == nil {
+		return builtinFactory
+	}
+	return e.factory
+}
+
+// config returns the configuration of the factory that created the error.
+func (e *Error) config() *Config {
+	return &e.Factory().config
+}
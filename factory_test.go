@@ -0,0 +1,175 @@
+package errorsx_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type FactorySuite struct {
+	suite.Suite
+}
+
+func (s *FactorySuite) TearDownTest() {
+	errorsx.SetDefaultFactory(nil)
+	errorsx.SetPanicHook(nil)
+}
+
+func (s *FactorySuite) TestIDPrefixAndOptions() {
+	f := errorsx.NewFactory(errorsx.Config{
+		IDPrefix: "billing",
+		Options:  []errorsx.Option{errorsx.WithType(errorsx.TypeInternal), errorsx.WithHTTPStatus(502)},
+	})
+
+	err := f.New("invoice.failed", errorsx.WithHTTPStatus(503))
+	s.Equal("billing.invoice.failed", err.ID())
+	s.Equal(errorsx.TypeInternal, err.Type())
+	s.Equal(503, err.HTTPStatus())
+	s.Same(f, err.Factory())
+	s.Same(f, err.WithReason("copied").Factory())
+	s.Require().ErrorIs(err, errorsx.New("billing.invoice.failed"))
+
+	s.True(f.NewNotFound("invoice.missing").IsNotFound())
+	s.True(f.NewRetryable("gateway.timeout").IsRetryable())
+	verr := f.NewValidationError("invoice.invalid")
+	s.Equal("billing.invoice.invalid", verr.BaseError.ID())
+	s.Equal(errorsx.TypeValidation, verr.BaseError.Type())
+}
+
+func (s *FactorySuite) TestTypeInferers() {
+	f := errorsx.NewFactory(errorsx.Config{
+		TypeInferers: []errorsx.ErrorTypeInferer{
+			errorsx.IDContainsInferer(map[string]errorsx.ErrorType{"timeout": errorsx.TypeTimeout}),
+		},
+	})
+	defer errorsx.RegisterTypeInferer("test.registered", 100, func(*errorsx.Error) errorsx.ErrorType {
+		return errorsx.TypeUnavailable
+	})()
+
+	s.Equal(errorsx.TypeTimeout, f.New("db.timeout").Type())
+	s.Equal(errorsx.TypeUnavailable, f.New("db.down").Type())
+	s.Equal(errorsx.TypeUnavailable, errorsx.New("db.timeout").Type())
+
+	x := errorsx.ExplainType(f.New("db.timeout"))
+	s.Require().Len(x.Steps, 1)
+	s.Equal(errorsx.SourceFactory, x.Steps[0].Source)
+	s.Contains(x.String(), "factory inferer: errorsx.timeout")
+}
+
+func (s *FactorySuite) TestStackTraceCleanerAndDepth() {
+	f := errorsx.NewFactory(errorsx.Config{
+		StackTraceCleaner: func([]string) []string { return []string{"cleaned"} },
+		MaxStackFrames:    1,
+	})
+
+	err := f.New("db.failed").WithCallerStack()
+	s.Require().Len(err.Stacks(), 1)
+	s.Len(err.Stacks()[0].Frames, 1)
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	s.Contains(string(data), `"frames":["cleaned"]`)
+
+	s.Len(err.WithStackTraceCleaner(nil).Stacks()[0].Frames, 1)
+	s.Greater(len(errorsx.New("db.failed").WithCallerStack().Stacks()[0].Frames), 1)
+}
+
+func (s *FactorySuite) TestPanicHook() {
+	var global, local []string
+	errorsx.SetPanicHook(func(extension string, _ *errorsx.Error) { global = append(global, extension) })
+	f := errorsx.NewFactory(errorsx.Config{
+		PanicHook: func(extension string, _ *errorsx.Error) { local = append(local, extension) },
+	})
+	broken := errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType { panic("bug") })
+
+	s.Equal(errorsx.TypeUnknown, f.New("db.failed", broken).Type())
+	s.Equal([]string{errorsx.ExtensionTypeInferer}, local)
+	s.Empty(global)
+
+	s.Equal(errorsx.TypeUnknown, errorsx.New("db.failed", broken).Type())
+	s.Equal([]string{errorsx.ExtensionTypeInferer}, global)
+}
+
+func (s *FactorySuite) TestDefaultFactory() {
+	f := errorsx.NewFactory(errorsx.Config{IDPrefix: "app"})
+	before := errorsx.New("db.failed")
+	errorsx.SetDefaultFactory(f)
+
+	s.Same(f, errorsx.DefaultFactory())
+	s.Equal("app.db.failed", errorsx.New("db.failed").ID())
+	s.Equal("app.user.missing", errorsx.NewNotFound("user.missing").ID())
+	s.Equal("app.form.invalid", errorsx.NewValidationError("form.invalid").BaseError.ID())
+	s.Equal("db.failed", before.ID())
+	s.NotSame(f, before.Factory())
+
+	errorsx.SetDefaultFactory(nil)
+	s.Equal("db.failed", errorsx.New("db.failed").ID())
+}
+
+func (s *FactorySuite) TestInternalIDsIgnoreDefaultFactory() {
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{
+		IDPrefix:     "app",
+		IDValidation: errorsx.IDValidationPanic,
+		IDPattern:    regexp.MustCompile(`^app\.`),
+		Options:      []errorsx.Option{errorsx.WithHTTPStatus(418)},
+	}))
+
+	var err error
+	s.NotPanics(func() {
+		defer errorsx.Recover(&err)
+		panic("boom")
+	})
+	s.Equal(errorsx.PanicID, errorsx.IDOf(err).String())
+	s.Require().ErrorIs(err, errorsx.ErrPanic)
+	value, ok := errorsx.PanicValue(err)
+	s.True(ok)
+	s.Equal("boom", value)
+
+	breaker := errorsx.NewBreaker("test.dependency", errorsx.WithBreakerThreshold(1))
+	ticket, _ := breaker.Allow()
+	breaker.Record(ticket, errors.New("failed"))
+	_, open := breaker.Allow()
+	s.Equal(errorsx.CircuitOpenID, errorsx.IDOf(open).String())
+	s.Require().ErrorIs(open, errorsx.ErrCircuitOpen)
+
+	s.Equal(errorsx.StdlibNoRowsID, errorsx.Classify(sql.ErrNoRows).ID())
+	s.Equal(errorsx.UnclassifiedID, errorsx.Classify(errors.New("other")).ID())
+
+	_, err = errorsx.LoadRules(strings.NewReader("{"))
+	s.Equal(errorsx.InvalidRulesID, errorsx.IDOf(err).String())
+}
+
+func (s *FactorySuite) TestConfigIsCopied() {
+	options := []errorsx.Option{errorsx.WithHTTPStatus(500)}
+	f := errorsx.NewFactory(errorsx.Config{Options: options})
+	options[0] = errorsx.WithHTTPStatus(418)
+
+	s.Equal(500, f.New("db.failed").HTTPStatus())
+	s.Equal(errorsx.MaxStackFrames, f.Config().MaxStackFrames)
+
+	config := f.Config()
+	config.Options[0] = errorsx.WithHTTPStatus(418)
+	s.Equal(500, f.New("db.failed").HTTPStatus())
+}
+
+func (s *FactorySuite) TestIsolation() {
+	// Factories with different policies coexist without touching package state.
+	a := errorsx.NewFactory(errorsx.Config{IDPrefix: "a", Options: []errorsx.Option{errorsx.WithType(errorsx.TypeTimeout)}})
+	b := errorsx.NewFactory(errorsx.Config{IDPrefix: "b"})
+
+	errA, errB := a.New("x"), b.New("x")
+	s.Equal(errorsx.TypeTimeout, errA.Type())
+	s.Equal(errorsx.TypeUnknown, errB.Type())
+	s.False(errors.Is(errA, errB))
+	s.Equal("x", errorsx.New("x").ID())
+}
+
+func TestFactorySuite(t *testing.T) {
+	suite.Run(t, new(FactorySuite))
+}
@@ -0,0 +1,60 @@
+package errorsx
+
+// FilterBy searches an error tree and returns all errorsx.Error instances for
+// which predicate returns true. The tree is traversed like FilterByType.
+//
+// Example:
+//
+//	withStatus := errorsx.FilterBy(err, func(e *errorsx.Error) bool {
+//		return e.HTTPStatus() != 0
+//	})
+//
+// Returns an empty slice if no errors match.
+func FilterBy(err error, predicate func(*Error) bool) []*Error {
+	var result []*Error
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && predicate(e) {
+			result = append(result, e)
+		}
+		return WalkContinue
+	})
+	return result
+}
+
+// FilterByID searches an error tree and returns all errorsx.Error instances
+// whose ID matches pattern, a glob over the segments of the ID with the
+// syntax of Rule.Pattern, as in IsID. "user.*" matches "user.not_found" but
+// not "user.login.failed", which "user.**" matches.
+//
+// Example:
+//
+//	userErrors := errorsx.FilterByID(err, "user.*")
+//
+// Returns an empty slice if no errors match or the pattern is malformed.
+func FilterByID(err error, pattern string) []*Error {
+	return FilterBy(err, func(e *Error) bool {
+		return ID(e.id).Match(pattern)
+	})
+}
+
+// FindAll searches an error tree and returns every node that is of type T.
+// Unlike errors.As, which stops at the first match, FindAll collects all
+// matches in the order they are visited by Walk. T may be a concrete error
+// type or an interface.
+//
+// Example:
+//
+//	pathErrors := errorsx.FindAll[*fs.PathError](err)
+//	timeouts := errorsx.FindAll[interface{ Timeout() bool }](err)
+//
+// Returns an empty slice if no nodes are of type T.
+func FindAll[T error](err error) []T {
+	var result []T
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if t, ok := node.(T); ok {
+			result = append(result, t)
+		}
+		return WalkContinue
+	})
+	return result
+}
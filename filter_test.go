@@ -0,0 +1,83 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterSuite struct {
+	suite.Suite
+}
+
+func ids(errs []*errorsx.Error) []string {
+	result := make([]string, len(errs))
+	for i, e := range errs {
+		result[i] = e.ID()
+	}
+	return result
+}
+
+func (s *FilterSuite) TestFilterByTypeKeepsTreeOrder() {
+	inner := errorsx.New("inner", errorsx.WithType(errorsx.TypeValidation))
+	middle := errorsx.New("middle", errorsx.WithType(errorsx.TypeValidation)).
+		WithCause(fmt.Errorf("wrapped: %w", inner))
+	sibling := errorsx.New("sibling", errorsx.WithType(errorsx.TypeValidation))
+	err := errorsx.Join(fmt.Errorf("outer: %w", middle), sibling)
+
+	s.Equal([]string{"middle", "inner", "sibling"}, ids(errorsx.FilterByType(err, errorsx.TypeValidation)))
+}
+
+func (s *FilterSuite) TestFilterBy() {
+	err := errorsx.Join(
+		errorsx.New("user.not_found", errorsx.WithHTTPStatus(404)),
+		errorsx.New("user.invalid"),
+		errorsx.New("db.unavailable", errorsx.WithHTTPStatus(503)),
+	)
+
+	result := errorsx.FilterBy(err, func(e *errorsx.Error) bool {
+		return e.HTTPStatus() != 0
+	})
+	s.Equal([]string{"user.not_found", "db.unavailable"}, ids(result))
+}
+
+func (s *FilterSuite) TestFilterByID() {
+	err := errorsx.Join(
+		errorsx.New("user.not_found"),
+		fmt.Errorf("wrapped: %w", errorsx.New("user.invalid")),
+		errorsx.New("order.not_found"),
+		errorsx.New("user.login.failed"),
+	)
+
+	s.Equal([]string{"user.not_found", "user.invalid"}, ids(errorsx.FilterByID(err, "user.*")))
+	s.Equal([]string{"user.not_found", "user.invalid", "user.login.failed"}, ids(errorsx.FilterByID(err, "user.**")))
+	s.Equal([]string{"user.not_found", "order.not_found"}, ids(errorsx.FilterByID(err, "*.not_found")))
+	s.Empty(errorsx.FilterByID(err, "["))
+}
+
+func (s *FilterSuite) TestFindAll() {
+	pathErr1 := &fs.PathError{Op: "open", Path: "a", Err: fs.ErrNotExist}
+	pathErr2 := &fs.PathError{Op: "open", Path: "b", Err: fs.ErrPermission}
+	err := errorsx.Join(
+		errorsx.New("config.load_failed").WithCause(pathErr1),
+		fmt.Errorf("wrapped: %w", pathErr2),
+		errors.New("plain"),
+	)
+
+	pathErrors := errorsx.FindAll[*fs.PathError](err)
+	s.Equal([]*fs.PathError{pathErr1, pathErr2}, pathErrors)
+
+	xerrs := errorsx.FindAll[*errorsx.Error](err)
+	s.Len(xerrs, 1)
+
+	s.Empty(errorsx.FindAll[*errorsx.ValidationError](err))
+	s.Empty(errorsx.FindAll[*fs.PathError](nil))
+}
+
+func TestFilterSuite(t *testing.T) {
+	suite.Run(t, new(FilterSuite))
+}
@@ -0,0 +1,198 @@
+package errorsx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// TaskError annotates an error returned by a Group task with the task's label and index.
+// It unwraps to the original error, so errors.Is, errors.As and FilterByType
+// continue to see the task's error and its stack traces.
+type TaskError struct {
+	// Label is the label passed to GoLabel, or empty for tasks started with Go.
+	Label string
+
+	// Index is the position of the task in the order it was started.
+	Index int
+
+	// Err is the error returned by the task.
+	Err error
+}
+
+// Error implements the standard error interface.
+// The message is prefixed with the task label, or with the task index if no label was given.
+func (t *TaskError) Error() string {
+	if t.Label != "" {
+		return fmt.Sprintf("%s: %s", t.Label, t.Err.Error())
+	}
+	return fmt.Sprintf("task %d: %s", t.Index, t.Err.Error())
+}
+
+// Unwrap returns the error returned by the task.
+func (t *TaskError) Unwrap() error {
+	return t.Err
+}
+
+// MarshalJSON implements the json.Marshaler interface for TaskError.
+// The task's error is marshaled with its own MarshalJSON when available.
+func (t *TaskError) MarshalJSON() ([]byte, error) {
+	child, err := marshalChild(t.Err)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Label string          `json:"label,omitempty"`
+		Index int             `json:"index"`
+		Error json.RawMessage `json:"error"`
+	}{Label: t.Label, Index: t.Index, Error: child})
+}
+
+// GroupOption configures a Group during creation.
+type GroupOption func(*Group)
+
+// WithCollectAll keeps the group's context alive when a task fails, so that
+// every task runs to completion and all errors are collected.
+// By default the context is canceled on the first failure.
+func WithCollectAll() GroupOption {
+	return func(g *Group) {
+		g.collectAll = true
+	}
+}
+
+// WithGroupLimit limits the number of tasks running concurrently.
+// See Group.SetLimit.
+func WithGroupLimit(n int) GroupOption {
+	return func(g *Group) {
+		g.SetLimit(n)
+	}
+}
+
+// Group runs tasks in goroutines and collects their errors, similar to
+// golang.org/x/sync/errgroup. Unlike errgroup, Wait returns every error
+// rather than only the first one, panics are converted into *Error values,
+// and each error is annotated with the task's label or index as a *TaskError.
+//
+// Example:
+//
+//	g, ctx := errorsx.NewGroup(ctx)
+//	g.SetLimit(4)
+//	for _, id := range ids {
+//		id := id
+//		g.GoLabel("user "+id, func() error {
+//			return syncUser(ctx, id)
+//		})
+//	}
+//	if err := g.Wait(); err != nil {
+//		validationErrs := errorsx.FilterByType(err, errorsx.TypeValidation)
+//		// ...
+//	}
+//
+// A zero Group is valid, has no limit and does not cancel on error.
+type Group struct {
+	cancel     context.CancelCauseFunc
+	collectAll bool
+	wg         sync.WaitGroup
+	sem        chan struct{}
+
+	mu    sync.Mutex
+	next  int
+	tasks []*TaskError
+}
+
+// NewGroup returns a new Group and a derived context.
+// Unless WithCollectAll is given, the derived context is canceled the first
+// time a task fails. It is always canceled once Wait returns.
+func NewGroup(ctx context.Context, opts ...GroupOption) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &Group{cancel: cancel}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g, ctx
+}
+
+// SetLimit limits the number of tasks running concurrently to n.
+// Go blocks while the limit is reached. A negative value removes the limit.
+// The limit must not be changed while tasks are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine. Its error is annotated with the task index.
+func (g *Group) Go(fn func() error) {
+	g.GoLabel("", fn)
+}
+
+// GoLabel runs fn in a new goroutine. Its error is annotated with label.
+func (g *Group) GoLabel(label string, fn func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.mu.Lock()
+	index := g.next
+	g.next++
+	g.mu.Unlock()
+
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+
+		if err := runTask(fn); err != nil {
+			g.fail(&TaskError{Label: label, Index: index, Err: err})
+		}
+	}()
+}
+
+// Wait blocks until all tasks have returned and returns the Join of their
+// errors ordered by task index, or nil if every task succeeded.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	sort.Slice(g.tasks, func(i, j int) bool {
+		return g.tasks[i].Index < g.tasks[j].Index
+	})
+	errs := make([]error, len(g.tasks))
+	for i, t := range g.tasks {
+		errs[i] = t
+	}
+
+	return Join(errs...)
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func (g *Group) fail(err *TaskError) {
+	g.mu.Lock()
+	g.tasks = append(g.tasks, err)
+	g.mu.Unlock()
+
+	if g.cancel != nil && !g.collectAll {
+		g.cancel(err)
+	}
+}
+
+func runTask(fn func() error) (err error) {
+	defer Recover(&err)
+	return fn()
+}
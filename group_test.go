@@ -0,0 +1,124 @@
+package errorsx_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupSuite struct {
+	suite.Suite
+}
+
+func (s *GroupSuite) TestWaitReturnsNilOnSuccess() {
+	g, _ := errorsx.NewGroup(context.Background())
+	for i := 0; i < 3; i++ {
+		g.Go(func() error { return nil })
+	}
+	s.Require().NoError(g.Wait())
+}
+
+func (s *GroupSuite) TestWaitJoinsAllErrorsInOrder() {
+	g, _ := errorsx.NewGroup(context.Background(), errorsx.WithCollectAll())
+
+	g.GoLabel("first", func() error {
+		time.Sleep(10 * time.Millisecond)
+		return errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation)).WithCallerStack()
+	})
+	g.Go(func() error { return nil })
+	g.Go(func() error {
+		return errorsx.New("db.failed").WithCallerStack()
+	})
+
+	err := g.Wait()
+	s.Require().Error(err)
+	s.Require().Equal("first: input.invalid; task 2: db.failed", err.Error())
+
+	var taskErr *errorsx.TaskError
+	s.Require().True(errors.As(err, &taskErr))
+	s.Require().Equal("first", taskErr.Label)
+	s.Require().Equal(0, taskErr.Index)
+
+	s.Require().Len(errorsx.FilterByType(err, errorsx.TypeValidation), 1)
+	s.Require().Contains(errorsx.FullStackTrace(errorsx.FilterByType(err, errorsx.TypeValidation)[0]),
+		"TestWaitJoinsAllErrorsInOrder")
+}
+
+func (s *GroupSuite) TestCancelOnFirstFailure() {
+	g, ctx := errorsx.NewGroup(context.Background())
+	failure := errorsx.New("upstream.failed")
+
+	g.Go(func() error { return failure })
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := g.Wait()
+	s.Require().True(errors.Is(err, failure))
+	s.Require().True(errors.Is(err, context.Canceled))
+	s.Require().True(errors.Is(context.Cause(ctx), failure))
+}
+
+func (s *GroupSuite) TestCollectAllDoesNotCancel() {
+	g, ctx := errorsx.NewGroup(context.Background(), errorsx.WithCollectAll())
+
+	g.Go(func() error { return errorsx.New("first.failed") })
+	g.Go(func() error {
+		time.Sleep(10 * time.Millisecond)
+		return ctx.Err()
+	})
+
+	err := g.Wait()
+	s.Require().False(errors.Is(err, context.Canceled))
+	s.Require().Error(ctx.Err(), "context is canceled once Wait returns")
+}
+
+func (s *GroupSuite) TestRecoversPanics() {
+	g, _ := errorsx.NewGroup(context.Background())
+	g.GoLabel("crasher", func() error {
+		panic("boom")
+	})
+
+	err := g.Wait()
+	s.Require().True(errors.Is(err, errorsx.ErrPanic))
+	s.Require().Equal("crasher: panic: boom", err.Error())
+}
+
+func (s *GroupSuite) TestLimit() {
+	g, _ := errorsx.NewGroup(context.Background(), errorsx.WithGroupLimit(2))
+	var running, peak int32
+
+	for i := 0; i < 8; i++ {
+		g.Go(func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+
+	s.Require().NoError(g.Wait())
+	s.Require().LessOrEqual(atomic.LoadInt32(&peak), int32(2))
+}
+
+func (s *GroupSuite) TestZeroGroup() {
+	var g errorsx.Group
+	g.Go(func() error { return errorsx.New("zero.failed") })
+	s.Require().EqualError(g.Wait(), "task 0: zero.failed")
+}
+
+func TestGroupSuite(t *testing.T) {
+	suite.Run(t, new(GroupSuite))
+}
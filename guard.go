@@ -0,0 +1,98 @@
+package errorsx
+
+import "sync/atomic"
+
+// Names of the extension points reported to a PanicHook.
+const (
+	ExtensionTypeInferer       = "type_inferer"
+	ExtensionStackTraceCleaner = "stack_trace_cleaner"
+	ExtensionFieldTranslator   = "field_translator"
+	ExtensionSummaryTranslator = "summary_translator"
+)
+
+// PanicHook is called when a user-supplied extension function panics.
+// extension is one of the Extension* constants, and err is the recovered
+// panic as returned by RecoverValue, with its type set to TypeInternal.
+type PanicHook func(extension string, err *Error)
+
+// panicHook is the hook set with SetPanicHook.
+var panicHook atomic.Pointer[PanicHook] //nolint:gochecknoglobals
+
+// SetPanicHook sets the hook that is notified when an ErrorTypeInferer,
+// StackTraceCleaner, FieldTranslator or SummaryTranslator panics.
+// A nil hook disables the notifications. Errors created by a Factory with a
+// Config.PanicHook report to that hook instead.
+//
+// Panics in these extension points never propagate: the package recovers
+// them and falls back to the default behavior, so that logging an error or
+// writing an error response cannot crash. Inferers fall back to TypeUnknown,
+// stack trace cleaners to the uncleaned frames, and translators to
+// DefaultFieldTranslator and DefaultSummaryTranslator.
+//
+// Example:
+//
+//	errorsx.SetPanicHook(func(extension string, err *errorsx.Error) {
+//		slog.Error("errorsx extension panicked", "extension", extension, "error", err)
+//	})
+func SetPanicHook(hook PanicHook) {
+	if hook == nil {
+		panicHook.Store(nil)
+		return
+	}
+	panicHook.Store(&hook)
+}
+
+// recoverExtension recovers a panic in an extension point of e, runs fallback
+// and reports the panic to the hook. It must be called directly by defer.
+func recoverExtension(extension string, e *Error, fallback func()) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	fallback()
+
+	hook := e.config().PanicHook
+	if hook == nil {
+		if h := panicHook.Load(); h != nil {
+			hook = *h
+		}
+	}
+	if hook != nil {
+		// The explicit type keeps inferers away from the report, so that a
+		// panicking inferer cannot recurse through a hook that inspects it.
+		callPanicHook(hook, extension, RecoverValue(r).WithType(TypeInternal))
+	}
+}
+
+// callPanicHook calls hook and discards a panic in the hook itself, which has
+// nowhere left to be reported.
+func callPanicHook(hook PanicHook, extension string, err *Error) {
+	defer func() { _ = recover() }()
+	hook(extension, err)
+}
+
+// safeInfer runs inferer and reports whether it panicked, in which case the
+// returned TypeUnknown is not an answer that may be cached.
+func safeInfer(inferer ErrorTypeInferer, e *Error) (typ ErrorType, panicked bool) {
+	defer recoverExtension(ExtensionTypeInferer, e, func() { typ, panicked = TypeUnknown, true })
+	return inferer(e), false
+}
+
+func safeClean(cleaner StackTraceCleaner, e *Error, frames []string) (cleaned []string) {
+	defer recoverExtension(ExtensionStackTraceCleaner, e, func() { cleaned = frames })
+	return cleaner(frames)
+}
+
+func safeTranslateField(translator FieldTranslator, e *Error, field, code string, message any) (msg string) {
+	defer recoverExtension(ExtensionFieldTranslator, e, func() {
+		msg = DefaultFieldTranslator(field, code, message)
+	})
+	return translator(field, code, message)
+}
+
+func safeTranslateSummary(translator SummaryTranslator, e *Error, fieldErrors []FieldError, messageData any) (msg string) {
+	defer recoverExtension(ExtensionSummaryTranslator, e, func() {
+		msg = DefaultSummaryTranslator(fieldErrors, messageData)
+	})
+	return translator(fieldErrors, messageData)
+}
@@ -0,0 +1,136 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type PanicHookSuite struct {
+	suite.Suite
+	reports []string
+	errs    []*errorsx.Error
+}
+
+func (s *PanicHookSuite) SetupTest() {
+	s.reports = nil
+	s.errs = nil
+	errorsx.SetPanicHook(func(extension string, err *errorsx.Error) {
+		s.reports = append(s.reports, extension)
+		s.errs = append(s.errs, err)
+	})
+}
+
+func (s *PanicHookSuite) TearDownTest() {
+	errorsx.SetPanicHook(nil)
+	errorsx.ClearGlobalTypeInferer()
+}
+
+func (s *PanicHookSuite) TestInstanceInferer() {
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType {
+		panic("inferer bug")
+	}))
+
+	s.NotPanics(func() {
+		s.Equal(errorsx.TypeUnknown, err.Type())
+	})
+	s.Equal([]string{errorsx.ExtensionTypeInferer}, s.reports)
+	s.Require().ErrorIs(s.errs[0], errorsx.ErrPanic)
+	s.Equal(errorsx.TypeInternal, s.errs[0].Type())
+	s.Equal("panic: inferer bug", s.errs[0].Error())
+}
+
+func (s *PanicHookSuite) TestRegisteredInfererFallsThrough() {
+	defer errorsx.RegisterTypeInferer("test.broken", 10, func(*errorsx.Error) errorsx.ErrorType {
+		panic(errors.New("broken"))
+	})()
+	errorsx.SetGlobalTypeInferer(func(*errorsx.Error) errorsx.ErrorType { return errorsx.TypeTimeout })
+
+	s.Equal(errorsx.TypeTimeout, errorsx.New("db.timeout").Type())
+	s.Equal([]string{errorsx.ExtensionTypeInferer}, s.reports)
+
+	x := errorsx.ExplainType(errorsx.New("db.timeout"))
+	s.Equal(errorsx.TypeTimeout, x.Type)
+}
+
+func (s *PanicHookSuite) TestStackTraceCleaner() {
+	err := errorsx.New("db.failed").WithCallerStack().WithStackTraceCleaner(func([]string) []string {
+		panic("cleaner bug")
+	})
+
+	var data []byte
+	s.NotPanics(func() {
+		var marshalErr error
+		data, marshalErr = json.Marshal(err)
+		s.Require().NoError(marshalErr)
+	})
+	s.Contains(string(data), "TestStackTraceCleaner")
+	s.Equal([]string{errorsx.ExtensionStackTraceCleaner}, s.reports)
+}
+
+func (s *PanicHookSuite) TestTranslators() {
+	verr := errorsx.NewValidationError("user.invalid").
+		WithFieldTranslator(func(string, string, any) string { panic("field bug") }).
+		WithSummaryTranslator(func([]errorsx.FieldError, any) string { panic("summary bug") })
+	verr.AddFieldError("email", "required", "Email is required")
+
+	s.NotPanics(func() {
+		s.Equal("user.invalid: email: Email is required", verr.Error())
+	})
+	s.Equal([]string{errorsx.ExtensionFieldTranslator}, s.reports)
+
+	s.reports = nil
+	var data []byte
+	s.NotPanics(func() {
+		var marshalErr error
+		data, marshalErr = json.Marshal(verr)
+		s.Require().NoError(marshalErr)
+	})
+	s.Contains(string(data), "Email is required")
+	s.ElementsMatch([]string{errorsx.ExtensionFieldTranslator, errorsx.ExtensionSummaryTranslator}, s.reports)
+}
+
+func (s *PanicHookSuite) TestPanickingHook() {
+	errorsx.SetPanicHook(func(string, *errorsx.Error) { panic("hook bug") })
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType {
+		panic("inferer bug")
+	}))
+
+	s.NotPanics(func() {
+		s.Equal(errorsx.TypeUnknown, err.Type())
+	})
+}
+
+func (s *PanicHookSuite) TestPanicIsNotCached() {
+	calls := 0
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType {
+		calls++
+		if calls == 1 {
+			panic("transient")
+		}
+		return errorsx.TypeTimeout
+	}))
+
+	s.Equal(errorsx.TypeUnknown, err.Type())
+	s.Equal(errorsx.TypeTimeout, err.Type())
+	s.Equal(errorsx.TypeTimeout, err.Type())
+	s.Equal(2, calls)
+}
+
+func (s *PanicHookSuite) TestWithoutHook() {
+	errorsx.SetPanicHook(nil)
+	err := errorsx.New("db.failed", errorsx.WithTypeInferer(func(*errorsx.Error) errorsx.ErrorType {
+		panic("inferer bug")
+	}))
+
+	s.NotPanics(func() {
+		s.Equal(errorsx.TypeUnknown, err.Type())
+	})
+}
+
+func TestPanicHookSuite(t *testing.T) {
+	suite.Run(t, new(PanicHookSuite))
+}
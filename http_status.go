@@ -1,5 +1,7 @@
 package errorsx
 
+import "errors"
+
 // WithHTTPStatus returns a copy of the error with the specified HTTP status code.
 // This is useful for web applications that need to map errors to appropriate
 // HTTP response codes.
@@ -19,23 +21,31 @@ package errorsx
 //   - 422 Unprocessable Entity: Semantic validation errors
 //   - 500 Internal Server Error: Server-side errors
 func (e *Error) WithHTTPStatus(status int) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.status = status
-	return &clone
+	return clone
 }
 
 // HTTPStatus returns the HTTP status code associated with this error.
-// Returns 0 if no HTTP status code was set.
+// If no HTTP status code was set, it falls back to the default status of the
+// error's type (see RegisterTypeInfo). Returns 0 if neither is available.
 //
 // This method is typically used by web frameworks or middleware to
 // determine the appropriate HTTP response code for an error.
 func (e *Error) HTTPStatus() int {
-	return e.status
+	if status := e.override().HTTPStatus; status != nil {
+		return *status
+	}
+	if e.status != 0 {
+		return e.status
+	}
+	return e.typeInfo().HTTPStatus
 }
 
 // HTTPStatus extracts the HTTP status code from any error.
-// If the error is an errorsx.Error with a status code, returns that code.
-// Otherwise returns 0.
+// It returns the status of the first error in the chain that provides one
+// through an HTTPStatus() int method, such as errorsx.Error, ValidationError
+// and MultiError. Wrappers without such a method, like fmt.Errorf("%w"), are unwrapped.
 //
 // This function enables HTTP status code extraction from any error in
 // an error chain, making it useful for middleware and error handlers.
@@ -51,8 +61,11 @@ func (e *Error) HTTPStatus() int {
 //
 // Returns 0 if no HTTP status is found or if err is nil.
 func HTTPStatus(err error) int {
-	if e, ok := err.(*Error); ok && e.status != 0 {
-		return e.status
+	for err != nil {
+		if s, ok := err.(interface{ HTTPStatus() int }); ok {
+			return s.HTTPStatus()
+		}
+		err = errors.Unwrap(err)
 	}
 	return 0
 }
@@ -0,0 +1,115 @@
+package errorsx
+
+import "strings"
+
+// ID is an error ID following the hierarchical "domain.operation.reason"
+// convention, such as "user.login.failed".
+//
+// Example:
+//
+//	id := errorsx.IDOf(err) // "user.login.failed"
+//	id.Domain()             // "user"
+//	id.Parent()             // "user.login"
+//	id.HasPrefix("user")    // true
+type ID string
+
+// String returns the ID as a string.
+func (id ID) String() string {
+	return string(id)
+}
+
+// Segments returns the dot-separated segments of the ID, or nil for an empty ID.
+func (id ID) Segments() []string {
+	if id == "" {
+		return nil
+	}
+	return strings.Split(string(id), ".")
+}
+
+// Domain returns the first segment of the ID.
+func (id ID) Domain() ID {
+	if i := strings.IndexByte(string(id), '.'); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
+
+// Parent returns the ID without its last segment, or an empty ID if the ID
+// has a single segment.
+func (id ID) Parent() ID {
+	if i := strings.LastIndexByte(string(id), '.'); i >= 0 {
+		return id[:i]
+	}
+	return ""
+}
+
+// HasPrefix reports whether prefix is the ID or one of its ancestors.
+// Only whole segments match, so "user.login" has the prefix "user" but not "us".
+func (id ID) HasPrefix(prefix ID) bool {
+	if !strings.HasPrefix(string(id), string(prefix)) {
+		return false
+	}
+	return len(id) == len(prefix) || prefix == "" || id[len(prefix)] == '.'
+}
+
+// Match reports whether the ID matches pattern, a glob over the segments of
+// the ID with the syntax of Rule.Pattern.
+func (id ID) Match(pattern string) bool {
+	return matchSegments(strings.Split(pattern, "."), strings.Split(string(id), "."))
+}
+
+// matchSegments reports whether the segments match the pattern segments,
+// where a "**" pattern segment matches zero or more whole segments.
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	return len(segments) > 0 && matchSegment(pattern[0], segments[0]) && matchSegments(pattern[1:], segments[1:])
+}
+
+// IDOf returns the ID of the first errorsx.Error in the chain of err.
+// Returns an empty ID if there is none.
+func IDOf(err error) ID {
+	var id ID
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok {
+			id = ID(e.id)
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return id
+}
+
+// IsID reports whether any errorsx.Error in the chain of err has an ID, or a
+// canonical ID (see RegisterIDAlias), matching pattern, with the syntax of
+// Rule.Pattern.
+//
+// Example:
+//
+//	if errorsx.IsID(err, "user.*") {
+//		// "user.not_found", "user.locked", ... but not "user.login.failed"
+//	}
+//
+//	if errorsx.IsID(err, "user.**") {
+//		// "user" and every ID below it
+//	}
+func IsID(err error, pattern string) bool {
+	found := false
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && (ID(e.id).Match(pattern) || ID(e.CanonicalID()).Match(pattern)) {
+			found = true
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return found
+}
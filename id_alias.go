@@ -0,0 +1,170 @@
+package errorsx
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// idAlias is a deprecated ID registered with RegisterIDAlias.
+type idAlias struct {
+	canonical string
+	reported  atomic.Bool
+}
+
+var (
+	// idAliases maps deprecated IDs to their aliases. The map is replaced,
+	// never modified, so that readers need no lock.
+	idAliases      atomic.Pointer[map[string]*idAlias] //nolint:gochecknoglobals
+	idAliasesMutex sync.Mutex                          //nolint:gochecknoglobals
+)
+
+// RegisterIDAlias registers legacy as a deprecated alias of canonical, for
+// IDs that have been renamed. Errors with either ID are equal for errors.Is,
+// and creating an error with the legacy ID reports it once to the
+// DeprecationHook.
+//
+// Aliases may chain: after renaming "user.missing" to "user.not_found" and
+// then to "user.absent", both older IDs resolve to "user.absent".
+// An empty canonical removes the alias.
+//
+// Example:
+//
+//	func init() {
+//		errorsx.RegisterIDAlias("user.missing", "user.not_found")
+//	}
+//
+//	errors.Is(errorsx.New("user.missing"), errorsx.New("user.not_found")) // true
+//
+// RegisterIDAlias panics if the alias would create a cycle.
+func RegisterIDAlias(legacy, canonical string) {
+	idAliasesMutex.Lock()
+	defer idAliasesMutex.Unlock()
+
+	current := loadIDAliases()
+	aliases := make(map[string]*idAlias, len(current)+1)
+	for id, alias := range current {
+		aliases[id] = alias
+	}
+
+	if canonical == "" {
+		delete(aliases, legacy)
+		idAliases.Store(&aliases)
+		return
+	}
+	for id := canonical; id != ""; id = lookupIDAlias(aliases, id) {
+		if id == legacy {
+			panic(fmt.Sprintf("errorsx: aliasing %q to %q creates a cycle", legacy, canonical))
+		}
+	}
+	aliases[legacy] = &idAlias{canonical: canonical}
+	idAliases.Store(&aliases)
+}
+
+func loadIDAliases() map[string]*idAlias {
+	if aliases := idAliases.Load(); aliases != nil {
+		return *aliases
+	}
+	return nil
+}
+
+func lookupIDAlias(aliases map[string]*idAlias, id string) string {
+	if alias, ok := aliases[id]; ok {
+		return alias.canonical
+	}
+	return ""
+}
+
+// CanonicalID returns the ID that id was renamed to, following chained
+// aliases registered with RegisterIDAlias. Returns id itself if it is not a
+// deprecated alias.
+func CanonicalID(id string) string {
+	aliases := loadIDAliases()
+	for {
+		alias, ok := aliases[id]
+		if !ok {
+			return id
+		}
+		id = alias.canonical
+	}
+}
+
+// LegacyIDs returns the deprecated IDs that resolve to the canonical ID of id,
+// in lexical order.
+func LegacyIDs(id string) []string {
+	canonical := CanonicalID(id)
+	var legacy []string
+	for alias := range loadIDAliases() {
+		if alias != canonical && CanonicalID(alias) == canonical {
+			legacy = append(legacy, alias)
+		}
+	}
+	sort.Strings(legacy)
+	return legacy
+}
+
+// CanonicalID returns the canonical ID of the error. See CanonicalID.
+func (e *Error) CanonicalID() string {
+	return CanonicalID(e.id)
+}
+
+// DeprecationHook is called the first time an error is created with a
+// deprecated ID registered with RegisterIDAlias.
+type DeprecationHook func(legacyID, canonicalID string)
+
+// deprecationHook is the hook set with SetDeprecationHook.
+var deprecationHook atomic.Pointer[DeprecationHook] //nolint:gochecknoglobals
+
+// SetDeprecationHook sets the hook that is notified once per deprecated ID
+// when an error is created with it. Without a hook, the deprecation is logged
+// with slog.Warn. Errors created by a Factory with a Config.DeprecationHook
+// report to that hook instead.
+//
+// Example:
+//
+//	errorsx.SetDeprecationHook(func(legacyID, canonicalID string) {
+//		metrics.DeprecatedErrorIDs.WithLabelValues(legacyID).Inc()
+//	})
+func SetDeprecationHook(hook DeprecationHook) {
+	if hook == nil {
+		deprecationHook.Store(nil)
+		return
+	}
+	deprecationHook.Store(&hook)
+}
+
+// reportDeprecatedID notifies the deprecation hook if e was created with a
+// deprecated ID that has not been reported yet.
+func (e *Error) reportDeprecatedID() {
+	alias, ok := loadIDAliases()[e.id]
+	if !ok || alias.reported.Swap(true) {
+		return
+	}
+
+	canonical := CanonicalID(e.id)
+	hook := e.config().DeprecationHook
+	if hook == nil {
+		if h := deprecationHook.Load(); h != nil {
+			hook = *h
+		}
+	}
+	if hook == nil {
+		slog.Warn("errorsx: error created with a deprecated ID", "id", e.id, "canonical_id", canonical)
+		return
+	}
+	hook(e.id, canonical)
+}
+
+// legacyIDsInJSON enables the canonical and legacy IDs in MarshalJSON output.
+var legacyIDsInJSON atomic.Bool //nolint:gochecknoglobals
+
+// SetLegacyIDsInJSON enables or disables ID migration in the JSON output of
+// errors. When enabled, "id" holds the canonical ID and "legacy_ids" the
+// deprecated aliases of it, so that clients matching either ID keep working
+// while an ID is being renamed. It is disabled by default, and "id" holds the
+// ID the error was created with.
+func SetLegacyIDsInJSON(enabled bool) {
+	legacyIDsInJSON.Store(enabled)
+}
@@ -0,0 +1,114 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type IDAliasSuite struct {
+	suite.Suite
+	reports [][2]string
+}
+
+func (s *IDAliasSuite) SetupTest() {
+	s.reports = nil
+	errorsx.SetDeprecationHook(func(legacyID, canonicalID string) {
+		s.reports = append(s.reports, [2]string{legacyID, canonicalID})
+	})
+	errorsx.RegisterIDAlias("user.missing", "user.not_found")
+}
+
+func (s *IDAliasSuite) TearDownTest() {
+	errorsx.RegisterIDAlias("user.missing", "")
+	errorsx.RegisterIDAlias("user.not_found", "")
+	errorsx.SetDeprecationHook(nil)
+	errorsx.SetLegacyIDsInJSON(false)
+}
+
+func (s *IDAliasSuite) TestIs() {
+	legacy := errorsx.New("user.missing")
+	canonical := errorsx.New("user.not_found")
+
+	s.Require().ErrorIs(fmt.Errorf("wrapped: %w", legacy), canonical)
+	s.Require().ErrorIs(canonical, legacy)
+	s.Require().NotErrorIs(legacy, errorsx.New("user.locked"))
+	s.Require().ErrorIs(legacy, errorsx.New("user", errorsx.WithPrefixMatch()))
+	s.True(errorsx.IsID(legacy, "user.not_*"))
+
+	s.Equal("user.missing", legacy.ID())
+	s.Equal("user.not_found", legacy.CanonicalID())
+	s.Equal("user.not_found", canonical.CanonicalID())
+}
+
+func (s *IDAliasSuite) TestChainedAliases() {
+	errorsx.RegisterIDAlias("user.not_found", "user.absent")
+
+	s.Equal("user.absent", errorsx.CanonicalID("user.missing"))
+	s.Equal([]string{"user.missing", "user.not_found"}, errorsx.LegacyIDs("user.absent"))
+	s.Equal([]string{"user.missing", "user.not_found"}, errorsx.LegacyIDs("user.missing"))
+	s.Require().ErrorIs(errorsx.New("user.missing"), errorsx.New("user.absent"))
+
+	s.Panics(func() { errorsx.RegisterIDAlias("user.absent", "user.missing") })
+	s.Panics(func() { errorsx.RegisterIDAlias("user.absent", "user.absent") })
+}
+
+func (s *IDAliasSuite) TestRemove() {
+	errorsx.RegisterIDAlias("user.missing", "")
+
+	s.Equal("user.missing", errorsx.CanonicalID("user.missing"))
+	s.Require().NotErrorIs(errorsx.New("user.missing"), errorsx.New("user.not_found"))
+}
+
+func (s *IDAliasSuite) TestDeprecationReportedOnce() {
+	errorsx.New("user.missing")
+	errorsx.NewNotFound("user.missing")
+	errorsx.New("user.not_found")
+
+	s.Equal([][2]string{{"user.missing", "user.not_found"}}, s.reports)
+
+	// Registering the alias again reports it again.
+	errorsx.RegisterIDAlias("user.missing", "user.not_found")
+	errorsx.New("user.missing")
+	s.Len(s.reports, 2)
+}
+
+func (s *IDAliasSuite) TestFactoryHook() {
+	var local []string
+	f := errorsx.NewFactory(errorsx.Config{
+		DeprecationHook: func(legacyID, _ string) { local = append(local, legacyID) },
+	})
+
+	f.New("user.missing")
+	s.Equal([]string{"user.missing"}, local)
+	s.Empty(s.reports)
+}
+
+func (s *IDAliasSuite) TestJSON() {
+	marshal := func(err *errorsx.Error) map[string]any {
+		data, marshalErr := json.Marshal(err)
+		s.Require().NoError(marshalErr)
+		var result map[string]any
+		s.Require().NoError(json.Unmarshal(data, &result))
+		return result
+	}
+
+	result := marshal(errorsx.New("user.missing"))
+	s.Equal("user.missing", result["id"])
+	s.NotContains(result, "legacy_ids")
+
+	errorsx.SetLegacyIDsInJSON(true)
+	for _, id := range []string{"user.missing", "user.not_found"} {
+		result = marshal(errorsx.New(id))
+		s.Equal("user.not_found", result["id"])
+		s.Equal([]any{"user.missing"}, result["legacy_ids"])
+	}
+	s.NotContains(marshal(errorsx.New("user.locked")), "legacy_ids")
+}
+
+func TestIDAliasSuite(t *testing.T) {
+	suite.Run(t, new(IDAliasSuite))
+}
@@ -0,0 +1,103 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type IDSuite struct {
+	suite.Suite
+}
+
+func (s *IDSuite) TestHierarchy() {
+	id := errorsx.ID("user.login.failed")
+
+	s.Equal([]string{"user", "login", "failed"}, id.Segments())
+	s.Equal(errorsx.ID("user"), id.Domain())
+	s.Equal(errorsx.ID("user.login"), id.Parent())
+	s.Equal(errorsx.ID("user"), id.Parent().Parent())
+	s.Equal(errorsx.ID(""), id.Parent().Parent().Parent())
+	s.Equal(errorsx.ID("user"), errorsx.ID("user").Domain())
+	s.Nil(errorsx.ID("").Segments())
+	s.Equal("user.login.failed", id.String())
+}
+
+func (s *IDSuite) TestHasPrefix() {
+	id := errorsx.ID("user.login.failed")
+
+	s.True(id.HasPrefix("user"))
+	s.True(id.HasPrefix("user.login"))
+	s.True(id.HasPrefix("user.login.failed"))
+	s.True(id.HasPrefix(""))
+	s.False(id.HasPrefix("us"))
+	s.False(id.HasPrefix("user.log"))
+	s.False(id.HasPrefix("user.login.failed.twice"))
+}
+
+func (s *IDSuite) TestMatch() {
+	tests := []struct {
+		pattern string
+		id      errorsx.ID
+		want    bool
+	}{
+		{"user.*", "user.not_found", true},
+		{"user.*", "user.login.failed", false},
+		{"user.**", "user", true},
+		{"user.**", "user.login.failed", true},
+		{"*.failed", "login.failed", true},
+		{"**.failed", "user.login.failed", true},
+		{"user.log?n.*", "user.login.failed", true},
+		{"user.not_found", "user.not_found", true},
+		{"user", "username", false},
+	}
+	for _, tt := range tests {
+		s.Run(tt.pattern+" "+string(tt.id), func() {
+			s.Equal(tt.want, tt.id.Match(tt.pattern))
+		})
+	}
+}
+
+func (s *IDSuite) TestIDOf() {
+	err := fmt.Errorf("handler: %w", errorsx.New("user.not_found"))
+
+	s.Equal(errorsx.ID("user.not_found"), errorsx.IDOf(err))
+	s.Equal(errorsx.ID("user"), errorsx.IDOf(err).Domain())
+	s.Equal(errorsx.ID(""), errorsx.IDOf(errors.New("plain")))
+	s.Equal(errorsx.ID(""), errorsx.IDOf(nil))
+}
+
+func (s *IDSuite) TestIsID() {
+	inner := errorsx.New("db.query.timeout")
+	err := fmt.Errorf("handler: %w", errorsx.New("user.fetch_failed").WithCause(inner))
+
+	s.True(errorsx.IsID(err, "user.*"))
+	s.True(errorsx.IsID(err, "db.**"))
+	s.True(errorsx.IsID(err, "**.timeout"))
+	s.True(errorsx.IsID(errorsx.Join(errors.New("plain"), inner), "db.query.*"))
+	s.False(errorsx.IsID(err, "order.*"))
+	s.False(errorsx.IsID(err, "db.*"))
+	s.False(errorsx.IsID(nil, "**"))
+}
+
+func (s *IDSuite) TestPrefixMatch() {
+	sentinel := errorsx.New("user", errorsx.WithPrefixMatch())
+	err := fmt.Errorf("handler: %w", errorsx.New("user.not_found"))
+
+	s.Require().ErrorIs(err, sentinel)
+	s.Require().ErrorIs(errorsx.New("user"), sentinel)
+	s.Require().ErrorIs(errorsx.New("user.login.failed"), sentinel)
+	s.Require().NotErrorIs(errorsx.New("username.taken"), sentinel)
+
+	// Without the option, IDs are compared exactly.
+	s.Require().NotErrorIs(err, errorsx.New("user"))
+	// The option only applies when the error is the target.
+	s.Require().NotErrorIs(sentinel, errorsx.New("user.not_found"))
+}
+
+func TestIDSuite(t *testing.T) {
+	suite.Run(t, new(IDSuite))
+}
@@ -0,0 +1,100 @@
+package errorsx
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// InvalidIDID is the ID of the errors reporting an ID that violates the ID grammar.
+const InvalidIDID = "errorsx.invalid_id"
+
+// IDValidationMode selects what happens when an error is created with an ID
+// that violates the ID grammar.
+type IDValidationMode int
+
+const (
+	// IDValidationOff accepts any ID. This is the default.
+	IDValidationOff IDValidationMode = iota
+
+	// IDValidationPanic panics with the violation, which suits tests.
+	IDValidationPanic
+
+	// IDValidationReport reports the violation to the IDViolationHook and
+	// creates the error anyway, which suits production.
+	IDValidationReport
+)
+
+// DefaultIDPattern is the ID grammar used when Config.IDPattern is nil:
+// lowercase dot-separated segments of letters, digits and underscores, each
+// starting with a letter, such as "user.login_failed".
+var DefaultIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`) //nolint:gochecknoglobals
+
+// IDViolationHook is called in IDValidationReport mode when an error is
+// created with an invalid ID. err describes the violation and has the ID
+// InvalidIDID.
+type IDViolationHook func(id string, err *Error)
+
+// ValidateID checks id against the ID grammar of the factory and returns an
+// error with the ID InvalidIDID describing the violation, or nil.
+// It validates regardless of Config.IDValidation.
+//
+// Besides the grammar, empty IDs and IDs that look like sentences, such as
+// "User not found.", are rejected.
+func (f *Factory) ValidateID(id string) error {
+	if err := f.idViolation(id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// idViolation returns the violation of the ID grammar by id, or nil.
+func (f *Factory) idViolation(id string) *Error {
+	pattern := f.config.IDPattern
+	if pattern == nil {
+		pattern = DefaultIDPattern
+	}
+
+	var reason string
+	switch {
+	case id == "":
+		reason = "ID is empty"
+	case looksLikeSentence(id):
+		reason = "ID looks like a sentence; pass messages with WithMessage or WithReason"
+	case !pattern.MatchString(id):
+		reason = "ID does not match " + pattern.String()
+	default:
+		return nil
+	}
+	// The built-in factory keeps the violation free of prefixes and validation.
+	return builtinFactory.New(InvalidIDID, WithType(TypeValidation)).WithReason("invalid error ID %q: %s", id, reason)
+}
+
+// looksLikeSentence reports whether id contains whitespace or ends with
+// sentence punctuation.
+func looksLikeSentence(id string) bool {
+	return strings.IndexFunc(id, unicode.IsSpace) >= 0 || strings.ContainsAny(id[len(id)-1:], ".!?")
+}
+
+// validateID enforces the IDValidationMode of the factory for a new error.
+func (f *Factory) validateID(id string) {
+	if f.config.IDValidation == IDValidationOff {
+		return
+	}
+	err := f.idViolation(id)
+	if err == nil {
+		return
+	}
+
+	switch f.config.IDValidation {
+	case IDValidationPanic:
+		panic(err)
+	case IDValidationReport:
+		if hook := f.config.IDViolationHook; hook != nil {
+			hook(id, err)
+			return
+		}
+		slog.Warn("errorsx: error created with an invalid ID", "id", id, "error", err)
+	}
+}
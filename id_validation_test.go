@@ -0,0 +1,114 @@
+package errorsx_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type IDValidationSuite struct {
+	suite.Suite
+}
+
+func (s *IDValidationSuite) TearDownTest() {
+	errorsx.SetDefaultFactory(nil)
+}
+
+func (s *IDValidationSuite) TestValidateID() {
+	f := errorsx.NewFactory(errorsx.Config{})
+
+	tests := []struct {
+		id     string
+		reason string
+	}{
+		{"user.not_found", ""},
+		{"user", ""},
+		{"payment.gateway2.timeout", ""},
+		{"", "ID is empty"},
+		{"User not found", "looks like a sentence"},
+		{"user.not_found.", "looks like a sentence"},
+		{"Something went wrong!", "looks like a sentence"},
+		{"User.NotFound", "does not match"},
+		{"user..not_found", "does not match"},
+		{"user-not-found", "does not match"},
+		{"2fa.failed", "does not match"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.id, func() {
+			err := f.ValidateID(tt.id)
+			if tt.reason == "" {
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().ErrorIs(err, errorsx.New(errorsx.InvalidIDID))
+			s.Contains(err.Error(), tt.reason)
+		})
+	}
+}
+
+func (s *IDValidationSuite) TestCustomPattern() {
+	f := errorsx.NewFactory(errorsx.Config{IDPattern: regexp.MustCompile(`^[A-Z][A-Za-z]*(\.[A-Z][A-Za-z]*)*$`)})
+
+	s.Require().NoError(f.ValidateID("User.NotFound"))
+	s.Require().Error(f.ValidateID("user.not_found"))
+	s.Require().Error(f.ValidateID("User not found"))
+}
+
+func (s *IDValidationSuite) TestOff() {
+	s.NotPanics(func() {
+		s.Equal("User not found", errorsx.NewNotFound("User not found").ID())
+	})
+}
+
+func (s *IDValidationSuite) TestPanic() {
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{IDValidation: errorsx.IDValidationPanic}))
+
+	for name, create := range map[string]func(){
+		"New":                func() { errorsx.New("") },
+		"NewNotFound":        func() { errorsx.NewNotFound("User not found") },
+		"NewRetryable":       func() { errorsx.NewRetryable("Try again later.") },
+		"NewValidationError": func() { errorsx.NewValidationError("Form.Invalid") },
+	} {
+		s.Run(name, func() {
+			defer func() {
+				err, ok := recover().(error)
+				s.Require().True(ok)
+				s.Require().ErrorIs(err, errorsx.New(errorsx.InvalidIDID))
+			}()
+			create()
+		})
+	}
+	s.NotPanics(func() { errorsx.New("user.not_found") })
+}
+
+func (s *IDValidationSuite) TestReport() {
+	var ids []string
+	var violations []*errorsx.Error
+	f := errorsx.NewFactory(errorsx.Config{
+		IDPrefix:     "Billing",
+		IDValidation: errorsx.IDValidationReport,
+		IDViolationHook: func(id string, err *errorsx.Error) {
+			ids = append(ids, id)
+			violations = append(violations, err)
+		},
+	})
+
+	err := f.New("invoice.not_found")
+	s.Equal("Billing.invoice.not_found", err.ID())
+	s.Equal([]string{"Billing.invoice.not_found"}, ids)
+	s.Equal(errorsx.InvalidIDID, violations[0].ID())
+	s.Contains(violations[0].Error(), "does not match")
+}
+
+func (s *IDValidationSuite) TestInheritedByNamespace() {
+	f := errorsx.NewFactory(errorsx.Config{IDValidation: errorsx.IDValidationPanic})
+
+	s.NotPanics(func() { f.Namespace("billing").New("invoice.not_found") })
+	s.Panics(func() { f.Namespace("billing").New("Invoice not found") })
+}
+
+func TestIDValidationSuite(t *testing.T) {
+	suite.Run(t, new(IDValidationSuite))
+}
@@ -1,9 +1,15 @@
 package errorsx
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
 )
 
+const multiStatusHTTPStatus = 207
+
 // Join returns an error that wraps the given errors.
 // Any nil error values are discarded.
 // Returns nil if all errors are nil.
@@ -12,6 +18,7 @@ import (
 //
 // This function is compatible with Go's standard errors.Join behavior
 // and supports both errors.Is and errors.As for unwrapping.
+// The returned error is a *MultiError.
 //
 // Example:
 //
@@ -29,7 +36,7 @@ func Join(errs ...error) error {
 	if n == 0 {
 		return nil
 	}
-	e := &joinError{
+	e := &MultiError{
 		errs: make([]error, 0, n),
 	}
 	for _, err := range errs {
@@ -40,11 +47,80 @@ func Join(errs ...error) error {
 	return e
 }
 
-type joinError struct {
-	errs []error
+// StatusPolicy computes the aggregate HTTP status of a MultiError from the
+// HTTP statuses of its children. Children without a status are passed as 0.
+type StatusPolicy func(statuses []int) int
+
+// MaxStatusPolicy returns the highest status, or 0 if no child has a status.
+// This is the default policy of MultiError.
+func MaxStatusPolicy(statuses []int) int {
+	status := 0
+	for _, s := range statuses {
+		if s > status {
+			status = s
+		}
+	}
+	return status
+}
+
+// MultiStatusPolicy returns the common status if every child with a status
+// agrees. Otherwise it returns 207 (Multi-Status) if some child succeeded,
+// 500 if some child failed with a 5xx status and 400 if every child failed
+// with a 4xx status. Returns 0 if no child has a status.
+func MultiStatusPolicy(statuses []int) int {
+	status := 0
+	succeeded := false
+	serverError := false
+	mixed := false
+	for _, s := range statuses {
+		if s == 0 {
+			continue
+		}
+		if s < badRequestHTTPStatus {
+			succeeded = true
+		}
+		if s >= serverErrorHTTPStatus {
+			serverError = true
+		}
+		if status != 0 && s != status {
+			mixed = true
+		}
+		status = s
+	}
+
+	switch {
+	case !mixed:
+		return status
+	case succeeded:
+		return multiStatusHTTPStatus
+	case serverError:
+		return serverErrorHTTPStatus
+	default:
+		return badRequestHTTPStatus
+	}
+}
+
+// MultiError is an error that wraps multiple errors, as returned by Join.
+//
+// Beyond errors.Is and errors.As support, MultiError aggregates the attributes
+// of its children:
+//   - HTTPStatus computes a status through a StatusPolicy (MaxStatusPolicy by default)
+//   - IsRetryable reports true only if every child is retryable
+//   - Type reports the type shared by every child, or TypeUnknown
+//   - MarshalJSON marshals every child with its own MarshalJSON
+//   - Formatting with %+v prints every child with its stack traces
+type MultiError struct {
+	errs         []error
+	statusPolicy StatusPolicy
+}
+
+// Errors returns a copy of the wrapped errors.
+func (e *MultiError) Errors() []error {
+	return append([]error(nil), e.errs...)
 }
 
-func (e *joinError) Error() string {
+// Error implements the standard error interface.
+func (e *MultiError) Error() string {
 	var b strings.Builder
 	for i, err := range e.errs {
 		if i > 0 {
@@ -55,6 +131,128 @@ func (e *joinError) Error() string {
 	return b.String()
 }
 
-func (e *joinError) Unwrap() []error {
+// Unwrap returns the wrapped errors, enabling errors.Is and errors.As to
+// search every child.
+func (e *MultiError) Unwrap() []error {
 	return e.errs
 }
+
+// WithStatusPolicy returns a copy of the error that computes its HTTP status with the given policy.
+//
+// Example:
+//
+//	err := errorsx.Join(errs...).(*errorsx.MultiError).
+//		WithStatusPolicy(errorsx.MultiStatusPolicy)
+func (e *MultiError) WithStatusPolicy(policy StatusPolicy) *MultiError {
+	clone := *e
+	clone.statusPolicy = policy
+	return &clone
+}
+
+// HTTPStatus returns the aggregate HTTP status of the children.
+func (e *MultiError) HTTPStatus() int {
+	statuses := make([]int, len(e.errs))
+	for i, err := range e.errs {
+		statuses[i] = HTTPStatus(err)
+	}
+
+	if e.statusPolicy != nil {
+		return e.statusPolicy(statuses)
+	}
+	return MaxStatusPolicy(statuses)
+}
+
+// IsRetryable returns true only if every child is retryable.
+func (e *MultiError) IsRetryable() bool {
+	for _, err := range e.errs {
+		if !IsRetryable(err) {
+			return false
+		}
+	}
+	return len(e.errs) > 0
+}
+
+// Type returns the ErrorType shared by every child, or TypeUnknown if the
+// children have different types or are not errorsx errors.
+func (e *MultiError) Type() ErrorType {
+	typ := TypeUnknown
+	for i, err := range e.errs {
+		var xerr *Error
+		if !errors.As(err, &xerr) {
+			return TypeUnknown
+		}
+		if i > 0 && xerr.Type() != typ {
+			return TypeUnknown
+		}
+		typ = xerr.Type()
+	}
+	return typ
+}
+
+// Format implements fmt.Formatter.
+// The %v and %s verbs print the same message as Error, %q prints it quoted,
+// and %+v prints every child on its own line followed by its stack traces.
+func (e *MultiError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		fmt.Fprintf(s, "%d errors occurred:", len(e.errs))
+		for i, err := range e.errs {
+			fmt.Fprintf(s, "\n[%d] %s", i, strings.ReplaceAll(formatVerbose(err), "\n", "\n    "))
+		}
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		_, _ = io.WriteString(s, e.Error())
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface for MultiError.
+// Every child is marshaled with its own MarshalJSON; children that do not
+// implement json.Marshaler are rendered with their message.
+func (e *MultiError) MarshalJSON() ([]byte, error) {
+	type jsonMultiError struct {
+		Errors      []json.RawMessage `json:"errors"`
+		Type        ErrorType         `json:"type"`
+		Status      int               `json:"status"`
+		IsRetryable bool              `json:"is_retryable,omitempty"`
+	}
+
+	children := make([]json.RawMessage, len(e.errs))
+	for i, err := range e.errs {
+		data, err := marshalChild(err)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = data
+	}
+
+	return json.Marshal(jsonMultiError{
+		Errors:      children,
+		Type:        e.Type(),
+		Status:      e.HTTPStatus(),
+		IsRetryable: e.IsRetryable(),
+	})
+}
+
+// marshalChild marshals a wrapped error, falling back to its message when it
+// does not implement json.Marshaler.
+func marshalChild(err error) (json.RawMessage, error) {
+	if m, ok := err.(json.Marshaler); ok {
+		return m.MarshalJSON()
+	}
+
+	return json.Marshal(struct {
+		Msg string `json:"msg"`
+	}{Msg: err.Error()})
+}
+
+// formatVerbose returns the %+v representation of a wrapped error.
+func formatVerbose(err error) string {
+	if _, ok := err.(fmt.Formatter); ok {
+		return fmt.Sprintf("%+v", err)
+	}
+	if trace := FullStackTrace(err); trace != "" {
+		return err.Error() + trace
+	}
+	return err.Error()
+}
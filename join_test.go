@@ -1,7 +1,9 @@
 package errorsx_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/hacomono-lib/go-errorsx"
@@ -94,3 +96,96 @@ func (s *JoinTestSuite) TestJoinWithMixedErrorTypes_IsAndAs() {
 	s.True(errors.As(joined, &gotVal), "errors.As should extract *ValidationError")
 	s.Equal(valErr.Error(), gotVal.Error())
 }
+
+func (s *JoinTestSuite) TestMultiErrorIsExported() {
+	joined := errorsx.Join(errorsx.New("error1"), errorsx.New("error2"))
+
+	var multi *errorsx.MultiError
+	s.Require().True(errors.As(joined, &multi))
+	s.Len(multi.Errors(), 2)
+}
+
+func (s *JoinTestSuite) TestMultiErrorMarshalJSON() {
+	valErr := errorsx.NewValidationError("validation.failed")
+	valErr.AddFieldError("email", "required", "Email is required")
+	joined := errorsx.Join(
+		errorsx.New("user.not_found", errorsx.WithHTTPStatus(404)),
+		valErr.WithHTTPStatus(400),
+		errors.New("standard error"),
+	)
+
+	data, err := json.Marshal(joined)
+	s.Require().NoError(err)
+
+	var result struct {
+		Errors []map[string]any `json:"errors"`
+		Status int              `json:"status"`
+	}
+	s.Require().NoError(json.Unmarshal(data, &result))
+	s.Require().Len(result.Errors, 3)
+	s.Equal("user.not_found", result.Errors[0]["id"])
+	s.Equal("validation.failed", result.Errors[1]["id"])
+	s.NotEmpty(result.Errors[1]["field_errors"])
+	s.Equal("standard error", result.Errors[2]["msg"])
+	s.Equal(404, result.Status)
+}
+
+func (s *JoinTestSuite) TestMultiErrorFormat() {
+	joined := errorsx.Join(
+		errorsx.New("error1").WithCallerStack(),
+		errors.New("error2"),
+	)
+
+	s.Equal("error1; error2", fmt.Sprintf("%v", joined))
+	s.Equal(`"error1; error2"`, fmt.Sprintf("%q", joined))
+
+	verbose := fmt.Sprintf("%+v", joined)
+	s.Contains(verbose, "2 errors occurred:")
+	s.Contains(verbose, "[0] error1")
+	s.Contains(verbose, "TestMultiErrorFormat")
+	s.Contains(verbose, "[1] error2")
+}
+
+func (s *JoinTestSuite) TestMultiErrorHTTPStatus() {
+	notFound := errorsx.New("user.not_found", errorsx.WithHTTPStatus(404))
+	invalid := errorsx.New("input.invalid", errorsx.WithHTTPStatus(422))
+	unavailable := errorsx.New("db.unavailable", errorsx.WithHTTPStatus(503))
+
+	joined := errorsx.Join(notFound, invalid, errors.New("no status"))
+	s.Equal(422, errorsx.HTTPStatus(joined))
+	s.Equal(422, errorsx.HTTPStatus(fmt.Errorf("wrapped: %w", joined)))
+
+	var multi *errorsx.MultiError
+	s.Require().True(errors.As(joined, &multi))
+	s.Equal(400, multi.WithStatusPolicy(errorsx.MultiStatusPolicy).HTTPStatus())
+
+	s.Equal(404, errorsx.MultiStatusPolicy([]int{404, 0, 404}))
+	s.Equal(500, errorsx.MultiStatusPolicy([]int{404, 503}))
+	s.Equal(500, errorsx.MultiStatusPolicy([]int{500, 503}))
+	s.Equal(500, errorsx.MultiStatusPolicy([]int{404, 500}))
+	s.Equal(400, errorsx.MultiStatusPolicy([]int{404, 422}))
+	s.Equal(207, errorsx.MultiStatusPolicy([]int{200, 404}))
+	s.Equal(207, errorsx.MultiStatusPolicy([]int{201, 503}))
+	s.Equal(503, errorsx.MaxStatusPolicy([]int{404, 503}))
+	s.Equal(0, errorsx.MaxStatusPolicy(nil))
+	s.Equal(503, errorsx.HTTPStatus(errorsx.Join(notFound, unavailable)))
+}
+
+func (s *JoinTestSuite) TestMultiErrorRetryable() {
+	retryable1 := errorsx.NewRetryable("network.timeout")
+	retryable2 := errorsx.NewRetryable("db.deadlock")
+	permanent := errorsx.New("input.invalid")
+
+	s.True(errorsx.IsRetryable(errorsx.Join(retryable1, retryable2)))
+	s.False(errorsx.IsRetryable(errorsx.Join(retryable1, permanent)))
+	s.False(errorsx.IsRetryable(errors.Join(retryable1, permanent)))
+	s.True(errorsx.IsRetryable(fmt.Errorf("wrapped: %w", errorsx.Join(retryable1, retryable2))))
+}
+
+func (s *JoinTestSuite) TestMultiErrorType() {
+	validation1 := errorsx.New("validation.email", errorsx.WithType(errorsx.TypeValidation))
+	validation2 := errorsx.New("validation.password", errorsx.WithType(errorsx.TypeValidation))
+
+	s.Equal(errorsx.TypeValidation, errorsx.Type(errorsx.Join(validation1, validation2)))
+	s.Equal(errorsx.TypeUnknown, errorsx.Type(errorsx.Join(validation1, errorsx.New("other"))))
+}
@@ -8,31 +8,35 @@ import (
 // MarshalJSON implements the json.Marshaler interface for Error, providing structured output for logging and APIs.
 func (e *Error) MarshalJSON() ([]byte, error) {
 	type jsonStack struct {
-		Msg    string   `json:"msg"`
-		Frames []string `json:"frames"`
+		Msg       string   `json:"msg"`
+		Frames    []string `json:"frames"`
+		Truncated bool     `json:"truncated,omitempty"`
 	}
 	type jsonCause struct {
 		Msg  string `json:"msg"`
 		Type string `json:"type"`
 	}
 	type jsonError struct {
-		ID          string      `json:"id"`
-		Msg         string      `json:"msg"`
-		Type        ErrorType   `json:"type"`
-		Status      int         `json:"status"`
-		MessageData any         `json:"message_data,omitempty"`
-		IsRetryable bool        `json:"is_retryable,omitempty"`
-		Stacks      []jsonStack `json:"stacks,omitempty"`
-		Cause       *jsonCause  `json:"cause,omitempty"`
+		ID          string           `json:"id"`
+		LegacyIDs   []string         `json:"legacy_ids,omitempty"`
+		Msg         string           `json:"msg"`
+		Type        ErrorType        `json:"type"`
+		Status      int              `json:"status"`
+		MessageData any              `json:"message_data,omitempty"`
+		IsRetryable bool             `json:"is_retryable,omitempty"`
+		RetryAfter  float64          `json:"retry_after,omitempty"`
+		Stacks      []jsonStack      `json:"stacks,omitempty"`
+		Cause       *jsonCause       `json:"cause,omitempty"`
+		Explanation *TypeExplanation `json:"type_explanation,omitempty"`
 	}
 
 	var stacks []jsonStack
 	for _, st := range e.stacks {
 		jsonFrames := toStackTraceLines(st)
 		if e.stackTraceCleaner != nil {
-			jsonFrames = e.stackTraceCleaner(jsonFrames)
+			jsonFrames = safeClean(e.stackTraceCleaner, e, jsonFrames)
 		}
-		stacks = append(stacks, jsonStack{Msg: st.Msg, Frames: jsonFrames})
+		stacks = append(stacks, jsonStack{Msg: st.Msg, Frames: jsonFrames, Truncated: st.Truncated})
 	}
 
 	var cause *jsonCause
@@ -44,15 +48,31 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	var explanation *TypeExplanation
+	if explainTypeInJSON.Load() {
+		x := ExplainType(e)
+		explanation = &x
+	}
+
+	id := e.id
+	var legacyIDs []string
+	if legacyIDsInJSON.Load() {
+		id = e.CanonicalID()
+		legacyIDs = LegacyIDs(id)
+	}
+
 	return json.Marshal(jsonError{
-		ID:          e.id,
+		ID:          id,
+		LegacyIDs:   legacyIDs,
 		Msg:         e.msg,
 		Type:        e.Type(),
-		Status:      e.status,
+		Status:      e.HTTPStatus(),
 		MessageData: e.messageData,
-		IsRetryable: e.isRetryable,
+		IsRetryable: e.IsRetryable(),
+		RetryAfter:  e.retryAfter.Seconds(),
 		Stacks:      stacks,
 		Cause:       cause,
+		Explanation: explanation,
 	})
 }
 
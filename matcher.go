@@ -0,0 +1,205 @@
+package errorsx
+
+import "errors"
+
+// Matcher is a predicate that tests a single node of an error tree.
+// Matchers are plain functions, so they can be used directly as predicates,
+// combined with And, Or and Not, applied to a whole error tree with Find or
+// Match, or used to route errors with Handle.
+//
+// Example:
+//
+//	userClientError := errorsx.And(
+//		errorsx.ByID("user.*"),
+//		errorsx.ByStatusRange(400, 499),
+//	)
+//	if errorsx.Match(err, userClientError) {
+//		// ...
+//	}
+type Matcher func(err error) bool
+
+// ByID matches errorsx errors whose ID matches pattern, a glob over the
+// segments of the ID with the syntax of Rule.Pattern, as in IsID.
+func ByID(pattern string) Matcher {
+	return func(err error) bool {
+		e, ok := err.(*Error)
+		return ok && ID(e.id).Match(pattern)
+	}
+}
+
+// ByType matches errorsx errors of the given ErrorType or of one of its
+// descendants in the error type hierarchy.
+func ByType(typ ErrorType) Matcher {
+	return func(err error) bool {
+		e, ok := err.(*Error)
+		return ok && IsTypeOf(e.Type(), typ)
+	}
+}
+
+// ByStatus matches errors whose HTTPStatus() method returns one of the given statuses.
+func ByStatus(statuses ...int) Matcher {
+	return func(err error) bool {
+		s, ok := err.(interface{ HTTPStatus() int })
+		if !ok {
+			return false
+		}
+		status := s.HTTPStatus()
+		for _, want := range statuses {
+			if status == want {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByStatusRange matches errors whose HTTPStatus() method returns a status
+// between minStatus and maxStatus inclusive. For example, ByStatusRange(400, 499)
+// matches all 4xx errors.
+func ByStatusRange(minStatus, maxStatus int) Matcher {
+	return func(err error) bool {
+		s, ok := err.(interface{ HTTPStatus() int })
+		if !ok {
+			return false
+		}
+		status := s.HTTPStatus()
+		return status >= minStatus && status <= maxStatus
+	}
+}
+
+// Retryable matches errors whose IsRetryable() method returns true.
+func Retryable() Matcher {
+	return func(err error) bool {
+		r, ok := err.(interface{ IsRetryable() bool })
+		return ok && r.IsRetryable()
+	}
+}
+
+// CauseIs matches errors for which errors.Is(err, target) is true.
+func CauseIs(target error) Matcher {
+	return func(err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
+// CauseAs matches errors for which errors.As finds an error of type T.
+//
+// Example:
+//
+//	errorsx.CauseAs[*json.SyntaxError]()
+func CauseAs[T error]() Matcher {
+	return func(err error) bool {
+		var target T
+		return errors.As(err, &target)
+	}
+}
+
+// And matches errors matched by every given matcher.
+func And(matchers ...Matcher) Matcher {
+	return func(err error) bool {
+		for _, m := range matchers {
+			if !m(err) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches errors matched by at least one of the given matchers.
+func Or(matchers ...Matcher) Matcher {
+	return func(err error) bool {
+		for _, m := range matchers {
+			if m(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches errors not matched by the given matcher.
+func Not(m Matcher) Matcher {
+	return func(err error) bool {
+		return !m(err)
+	}
+}
+
+// Find returns the first node of the error tree, in the order visited by
+// Walk, that is matched by m. Returns nil if no node matches.
+//
+// Example:
+//
+//	if found := errorsx.Find(err, errorsx.ByID("payment.*")); found != nil {
+//		log.Warn("payment failure", "id", found.(*errorsx.Error).ID())
+//	}
+func Find(err error, m Matcher) error {
+	var found error
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if m(node) {
+			found = node
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return found
+}
+
+// Match reports whether any node of the error tree is matched by m.
+func Match(err error, m Matcher) bool {
+	return Find(err, m) != nil
+}
+
+// Router dispatches an error to the first handler whose matcher matches any
+// node of the error tree. It replaces long if/else chains of errors.Is and
+// HasType checks.
+//
+// Example:
+//
+//	return errorsx.Handle(err).
+//		On(errorsx.ByType(errorsx.TypeValidation), renderBadRequest).
+//		On(errorsx.CauseIs(sql.ErrNoRows), renderNotFound).
+//		On(errorsx.Retryable(), renderUnavailable).
+//		Default(renderInternalError)
+type Router struct {
+	err     error
+	handled bool
+	result  error
+}
+
+// Handle starts routing err. A nil error is never handled.
+func Handle(err error) *Router {
+	return &Router{err: err}
+}
+
+// On calls fn with the routed error if no previous handler has run and m
+// matches any node of the error tree. The result of fn becomes the result of
+// the router.
+func (r *Router) On(m Matcher, fn func(error) error) *Router {
+	if r.handled || r.err == nil {
+		return r
+	}
+	if Match(r.err, m) {
+		r.handled = true
+		r.result = fn(r.err)
+	}
+	return r
+}
+
+// Default calls fn with the routed error if no handler has run, and returns the result of the router.
+// Returns nil without calling fn if the routed error is nil.
+func (r *Router) Default(fn func(error) error) error {
+	if !r.handled && r.err != nil {
+		r.handled = true
+		r.result = fn(r.err)
+	}
+	return r.result
+}
+
+// Err returns the result of the handler that ran, or the routed error itself if no handler ran.
+func (r *Router) Err() error {
+	if r.handled {
+		return r.result
+	}
+	return r.err
+}
@@ -0,0 +1,120 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type MatcherSuite struct {
+	suite.Suite
+}
+
+func (s *MatcherSuite) TestNodeMatchers() {
+	err := errorsx.New("user.not_found",
+		errorsx.WithType(errorsx.TypeNotFound),
+		errorsx.WithHTTPStatus(404),
+	)
+
+	s.True(errorsx.ByID("user.*")(err))
+	s.False(errorsx.ByID("order.*")(err))
+	s.True(errorsx.ByType(errorsx.TypeNotFound)(err))
+	s.False(errorsx.ByType(errorsx.TypeValidation)(err))
+	s.True(errorsx.ByStatus(400, 404)(err))
+	s.False(errorsx.ByStatus(500)(err))
+	s.True(errorsx.ByStatusRange(400, 499)(err))
+	s.False(errorsx.ByStatusRange(500, 599)(err))
+	s.False(errorsx.Retryable()(err))
+	s.True(errorsx.Retryable()(errorsx.NewRetryable("network.timeout")))
+	s.False(errorsx.ByID("*")(errors.New("plain")))
+
+	nested := errorsx.New("user.login.failed")
+	s.False(errorsx.ByID("user.*")(nested))
+	s.True(errorsx.ByID("user.**")(nested))
+	s.Equal(errorsx.IsID(nested, "user.*"), errorsx.Match(nested, errorsx.ByID("user.*")))
+}
+
+func (s *MatcherSuite) TestCauseMatchers() {
+	err := errorsx.New("request.decode_failed").WithCause(fmt.Errorf("decode: %w", io.ErrUnexpectedEOF))
+
+	s.True(errorsx.CauseIs(io.ErrUnexpectedEOF)(err))
+	s.False(errorsx.CauseIs(io.EOF)(err))
+	s.False(errorsx.CauseAs[*json.SyntaxError]()(err))
+
+	var payload map[string]any
+	jsonErr := errorsx.New("request.decode_failed").WithCause(json.Unmarshal([]byte("{]"), &payload))
+	s.True(errorsx.CauseAs[*json.SyntaxError]()(jsonErr))
+}
+
+func (s *MatcherSuite) TestCombinators() {
+	err := errorsx.New("user.invalid", errorsx.WithHTTPStatus(400))
+
+	s.True(errorsx.And(errorsx.ByID("user.*"), errorsx.ByStatus(400))(err))
+	s.False(errorsx.And(errorsx.ByID("user.*"), errorsx.ByStatus(404))(err))
+	s.True(errorsx.Or(errorsx.ByID("order.*"), errorsx.ByStatus(400))(err))
+	s.False(errorsx.Or(errorsx.ByID("order.*"), errorsx.ByStatus(404))(err))
+	s.True(errorsx.Not(errorsx.ByID("order.*"))(err))
+}
+
+func (s *MatcherSuite) TestFindAndMatch() {
+	target := errorsx.New("payment.declined")
+	err := errorsx.Join(
+		errorsx.New("user.invalid"),
+		fmt.Errorf("wrapped: %w", target),
+	)
+
+	s.Equal(target, errorsx.Find(err, errorsx.ByID("payment.*")))
+	s.Nil(errorsx.Find(err, errorsx.ByID("order.*")))
+	s.True(errorsx.Match(err, errorsx.ByID("user.*")))
+	s.False(errorsx.Match(nil, errorsx.ByID("*")))
+
+	// Matchers work as plain predicates for FilterBy
+	s.Len(errorsx.FilterBy(err, func(e *errorsx.Error) bool { return errorsx.ByID("*.*")(e) }), 2)
+}
+
+func (s *MatcherSuite) TestRouter() {
+	route := func(err error) string {
+		var handledBy string
+		handler := func(name string) func(error) error {
+			return func(error) error {
+				handledBy = name
+				return nil
+			}
+		}
+		_ = errorsx.Handle(err).
+			On(errorsx.ByType(errorsx.TypeValidation), handler("validation")).
+			On(errorsx.CauseIs(io.EOF), handler("eof")).
+			On(errorsx.Retryable(), handler("retry")).
+			Default(handler("default"))
+		return handledBy
+	}
+
+	s.Equal("validation", route(errorsx.NewValidationError("form.invalid")))
+	s.Equal("eof", route(fmt.Errorf("read: %w", io.EOF)))
+	s.Equal("retry", route(errorsx.Join(errorsx.NewRetryable("network.timeout"))))
+	s.Equal("default", route(errors.New("unexpected")))
+	s.Equal("", route(nil))
+}
+
+func (s *MatcherSuite) TestRouterResult() {
+	converted := errorsx.New("http.bad_request")
+	err := errorsx.New("input.invalid", errorsx.WithType(errorsx.TypeValidation))
+
+	result := errorsx.Handle(err).
+		On(errorsx.ByType(errorsx.TypeValidation), func(error) error { return converted }).
+		On(errorsx.ByID("*"), func(error) error { return errors.New("not reached") }).
+		Err()
+	s.Equal(converted, result)
+
+	unhandled := errors.New("unhandled")
+	s.Equal(unhandled, errorsx.Handle(unhandled).On(errorsx.ByID("*"), nil).Err())
+}
+
+func TestMatcherSuite(t *testing.T) {
+	suite.Run(t, new(MatcherSuite))
+}
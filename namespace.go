@@ -0,0 +1,59 @@
+package errorsx
+
+import "strings"
+
+// Namespace returns a factory whose errors have IDs prefixed with name and
+// receive opts as default options. It derives from the configuration of
+// DefaultFactory at the time of the call.
+//
+// Declaring one namespace per package keeps the hierarchical ID convention
+// described in New by construction:
+//
+//	var billing = errorsx.Namespace("billing",
+//		errorsx.WithType(errorsx.TypeInternal),
+//		errorsx.WithHTTPStatus(500),
+//	)
+//
+//	var ErrInvoiceNotFound = billing.NewNotFound("invoice.not_found") // "billing.invoice.not_found"
+func Namespace(name string, opts ...Option) *Factory {
+	return DefaultFactory().Namespace(name, opts...)
+}
+
+// Namespace returns a factory with the configuration of f, the ID prefix
+// extended by name and opts appended to the default options.
+//
+// Example:
+//
+//	invoices := billing.Namespace("invoice")
+//	err := invoices.New("not_found") // "billing.invoice.not_found"
+func (f *Factory) Namespace(name string, opts ...Option) *Factory {
+	config := f.Config()
+	name = strings.Trim(name, ".")
+	if config.IDPrefix != "" && name != "" {
+		config.IDPrefix += "." + name
+	} else if name != "" {
+		config.IDPrefix = name
+	}
+	config.Options = append(config.Options, opts...)
+	return NewFactory(config)
+}
+
+// IsNamespace reports whether any errorsx.Error in the chain of err has an ID
+// in the namespace ns, that is, an ID equal to ns or starting with ns and a dot.
+//
+// Example:
+//
+//	if errorsx.IsNamespace(err, "billing") {
+//		metrics.BillingErrors.Inc()
+//	}
+func IsNamespace(err error, ns string) bool {
+	found := false
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && ns != "" && ID(e.id).HasPrefix(ID(ns)) {
+			found = true
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return found
+}
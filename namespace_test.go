@@ -0,0 +1,83 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type NamespaceSuite struct {
+	suite.Suite
+}
+
+func (s *NamespaceSuite) TearDownTest() {
+	errorsx.SetDefaultFactory(nil)
+}
+
+func (s *NamespaceSuite) TestNew() {
+	billing := errorsx.Namespace("billing", errorsx.WithType(errorsx.TypeInternal), errorsx.WithHTTPStatus(502))
+
+	err := billing.New("invoice.not_found")
+	s.Equal("billing.invoice.not_found", err.ID())
+	s.Equal(errorsx.TypeInternal, err.Type())
+	s.Equal(502, err.HTTPStatus())
+
+	overridden := billing.New("invoice.not_found", errorsx.WithHTTPStatus(404))
+	s.Equal(404, overridden.HTTPStatus())
+	s.Require().ErrorIs(overridden, err)
+}
+
+func (s *NamespaceSuite) TestNested() {
+	billing := errorsx.Namespace("billing", errorsx.WithHTTPStatus(500))
+	invoices := billing.Namespace("invoice", errorsx.WithRetryable())
+
+	err := invoices.New("not_found")
+	s.Equal("billing.invoice.not_found", err.ID())
+	s.Equal(500, err.HTTPStatus())
+	s.True(err.IsRetryable())
+
+	s.Equal("billing.charge_failed", billing.New("charge_failed").ID())
+	s.False(billing.New("charge_failed").IsRetryable())
+	s.Equal("billing.x", errorsx.Namespace(".billing.").New("x").ID())
+}
+
+func (s *NamespaceSuite) TestInheritsDefaultFactory() {
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{IDPrefix: "app"}))
+
+	s.Equal("app.billing.invoice.not_found", errorsx.Namespace("billing").New("invoice.not_found").ID())
+}
+
+func (s *NamespaceSuite) TestIsNamespace() {
+	err := errorsx.Namespace("billing").New("invoice.not_found")
+	wrapped := fmt.Errorf("checkout: %w", errorsx.New("checkout.failed").WithCause(err))
+
+	tests := []struct {
+		name string
+		err  error
+		ns   string
+		want bool
+	}{
+		{"exact", err, "billing.invoice.not_found", true},
+		{"root", err, "billing", true},
+		{"intermediate", err, "billing.invoice", true},
+		{"chain", wrapped, "billing", true},
+		{"outer", wrapped, "checkout", true},
+		{"partial segment", err, "bill", false},
+		{"other", wrapped, "shipping", false},
+		{"joined", errorsx.Join(errors.New("plain"), err), "billing", true},
+		{"plain", errors.New("billing.failed"), "billing", false},
+		{"nil", nil, "billing", false},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, errorsx.IsNamespace(tt.err, tt.ns))
+		})
+	}
+}
+
+func TestNamespaceSuite(t *testing.T) {
+	suite.Run(t, new(NamespaceSuite))
+}
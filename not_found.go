@@ -5,21 +5,35 @@ import "errors"
 // WithNotFound returns a copy of the error marked as a "not found" error.
 // This is a convenience method for common "not found" scenarios.
 //
+// If the error has no type yet, its type is set to TypeNotFound, so that it
+// also receives the defaults of TypeNotFound such as the 404 HTTP status.
+//
 // Example:
 //
-//	err := errorsx.New("user.not_found").
-//		WithNotFound().
-//		WithHTTPStatus(404)
+//	err := errorsx.New("user.not_found").WithNotFound()
+//	err.Type()       // TypeNotFound
+//	err.HTTPStatus() // 404
 func (e *Error) WithNotFound() *Error {
-	clone := *e
-	clone.isNotFound = true
-	return &clone
+	clone := e.clone()
+	clone.markNotFound()
+	return clone
 }
 
 // IsNotFound returns true if this error represents a "not found" condition.
 // This provides a semantic way to check for missing resources or entities.
+//
+// An error is "not found" if it was marked with WithNotFound, or if its type
+// is TypeNotFound or one of its descendants.
 func (e *Error) IsNotFound() bool {
-	return e.isNotFound
+	return e.isNotFound || IsTypeOf(e.Type(), TypeNotFound)
+}
+
+// markNotFound sets the "not found" flag and, for untyped errors, TypeNotFound.
+func (e *Error) markNotFound() {
+	e.isNotFound = true
+	if e.errType == TypeUnknown && e.typeInferer == nil {
+		e.errType = TypeNotFound
+	}
 }
 
 // NewNotFound creates a new "not found" error with the given ID.
@@ -30,8 +44,9 @@ func (e *Error) IsNotFound() bool {
 //
 //	err := errorsx.NewNotFound("user.not_found")
 //	// Equivalent to: errorsx.New("user.not_found").WithNotFound()
+//	// err.Type() == errorsx.TypeNotFound
 func NewNotFound(idOrMsg string) *Error {
-	return New(idOrMsg).WithNotFound()
+	return DefaultFactory().NewNotFound(idOrMsg)
 }
 
 // IsNotFound checks if any error in the error chain represents a "not found" condition.
@@ -1,5 +1,7 @@
 package errorsx
 
+import "time"
+
 // Option represents a function that configures an Error during creation.
 // Options follow the functional options pattern, allowing flexible
 // and extensible error configuration.
@@ -95,19 +97,66 @@ func WithMessage(data any) Option {
 	}
 }
 
+// WithPrefixMatch makes the error match, as the target of errors.Is, every
+// error whose ID is its ID or a descendant of it. This lets a sentinel stand
+// for a whole group of errors.
+//
+// Example:
+//
+//	var ErrUser = errorsx.New("user", errorsx.WithPrefixMatch())
+//
+//	errors.Is(errorsx.New("user.not_found"), ErrUser) // true
+//	errors.Is(errorsx.New("username.taken"), ErrUser) // false
+func WithPrefixMatch() Option {
+	return func(e *Error) {
+		e.prefixMatch = true
+	}
+}
+
+// WithStackDepth sets the maximum number of frames captured in the stack
+// traces of the error, overriding Config.MaxStackFrames. A depth of zero or
+// less keeps Config.MaxStackFrames.
+//
+// Example:
+//
+//	err := errorsx.New("handler.failed",
+//		errorsx.WithStackDepth(128),
+//	).WithCause(cause)
+func WithStackDepth(depth int) Option {
+	return func(e *Error) {
+		e.maxStackFrames = depth
+	}
+}
+
+// WithStackCapture sets how much of the stack the error captures,
+// overriding Config.StackCapture.
+//
+// Example:
+//
+//	err := errorsx.New("cache.miss",
+//		errorsx.WithStackCapture(errorsx.StackCaptureCaller),
+//	).WithCause(cause)
+func WithStackCapture(mode StackCaptureMode) Option {
+	return func(e *Error) {
+		e.stackCapture = mode
+	}
+}
+
 // WithNotFound marks the error as a "not found" error.
-// This is a convenience method that's equivalent to WithType(TypeNotFound)
-// but can be used in combination with other types for more specific classification.
+// If no type has been set yet, this is equivalent to WithType(TypeNotFound),
+// so the error also receives the 404 default HTTP status of TypeNotFound.
+// Combined with a more specific type, the type is kept and the error is
+// still reported as "not found" by IsNotFound.
 //
 // Example:
 //
 //	err := errorsx.New("user.not_found",
 //		errorsx.WithNotFound(),
-//		errorsx.WithHTTPStatus(404),
 //	)
+//	// err.Type() == errorsx.TypeNotFound, err.HTTPStatus() == 404
 func WithNotFound() Option {
 	return func(e *Error) {
-		e.isNotFound = true
+		e.markNotFound()
 	}
 }
 
@@ -126,3 +175,19 @@ func WithRetryable() Option {
 		e.isRetryable = true
 	}
 }
+
+// WithRetryAfter marks the error as retryable and attaches a hint describing
+// how long the caller should wait before retrying.
+//
+// Example:
+//
+//	err := errorsx.New("service.unavailable",
+//		errorsx.WithRetryAfter(5*time.Second),
+//		errorsx.WithHTTPStatus(503),
+//	)
+func WithRetryAfter(d time.Duration) Option {
+	return func(e *Error) {
+		e.isRetryable = true
+		e.retryAfter = d
+	}
+}
@@ -0,0 +1,183 @@
+package errorsx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// PanicID is the ID of errors created from recovered panics.
+const PanicID = "errorsx.panic"
+
+// ErrPanic is a sentinel error for comparing against errors created from recovered panics.
+//
+// Example:
+//
+//	if errors.Is(err, errorsx.ErrPanic) {
+//		// A worker panicked
+//	}
+var ErrPanic = builtinFactory.New(PanicID) //nolint:gochecknoglobals
+
+// panicStackBuffer is the number of extra frames captured before runtime
+// frames are removed, leaving room for the panic machinery.
+const panicStackBuffer = MaxStackFrames
+
+// PanicError holds a recovered panic value that does not implement error.
+// It is used as the cause of panic errors so that the original value is preserved.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+}
+
+// Error implements the standard error interface.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// RecoverValue converts a value returned by recover() into an *Error with the
+// ID PanicID. The original value is kept as the cause: error values are used
+// as-is, other values are wrapped in a *PanicError. The stack trace of the
+// panicking goroutine is captured with runtime frames removed.
+//
+// Returns nil if v is nil.
+//
+// Example:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			log.Error("worker crashed", "error", errorsx.RecoverValue(r))
+//		}
+//	}()
+func RecoverValue(v any) *Error {
+	if v == nil {
+		return nil
+	}
+
+	cause, ok := v.(error)
+	if !ok {
+		cause = &PanicError{Value: v}
+	}
+
+	e := newInternal(PanicID).WithReason("panic: %v", v)
+	e.cause = cause
+	if depth := e.stackDepth(); depth > 0 {
+		frames, truncated := panicCallers(depth)
+		e.stacks = []StackTrace{{Frames: frames, Msg: e.msg, Truncated: e.truncated(truncated)}}
+	}
+	e.isStacked = true
+
+	return e
+}
+
+// Recover converts a panic into an *Error and stores it in *errp.
+// It must be called directly by defer; an existing error in *errp is replaced.
+//
+// Example:
+//
+//	func process() (err error) {
+//		defer errorsx.Recover(&err)
+//		// ...
+//	}
+func Recover(errp *error) {
+	if r := recover(); r != nil {
+		*errp = RecoverValue(r)
+	}
+}
+
+// Go runs fn in a new goroutine, converting panics into *Error values.
+// A non-nil error returned by fn, or the error created from a panic, is
+// passed to handler. A nil handler discards the error.
+//
+// Example:
+//
+//	errorsx.Go(worker.Run, func(err error) {
+//		log.Error("worker failed", "error", err)
+//	})
+func Go(fn func() error, handler func(error)) {
+	go func() {
+		var err error
+		defer func() {
+			if err != nil && handler != nil {
+				handler(err)
+			}
+		}()
+		defer Recover(&err)
+
+		err = fn()
+	}()
+}
+
+// PanicValue returns the value passed to panic for errors created by RecoverValue.
+// It searches the error tree for a panic error and returns its original value.
+//
+// Returns false if no panic error is found in the chain.
+func PanicValue(err error) (any, bool) {
+	var (
+		value any
+		found bool
+	)
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		e, ok := node.(*Error)
+		if !ok || e.id != PanicID || e.cause == nil {
+			return WalkContinue
+		}
+		value, found = e.cause, true
+		var p *PanicError
+		if errors.As(e.cause, &p) {
+			value = p.Value
+		}
+		return WalkStop
+	})
+	return value, found
+}
+
+// panicCallers captures up to depth frames of the stack of the panicking
+// goroutine and reports whether frames were left out.
+// When called during a panic, frames up to and including runtime.gopanic are
+// dropped so that the trace starts at the panic site. Remaining runtime frames
+// are removed as well.
+func panicCallers(depth int) ([]uintptr, bool) {
+	pcs := make([]uintptr, depth+panicStackBuffer)
+	n := runtime.Callers(1, pcs)
+
+	start := 0
+	for i, pc := range pcs[:n] {
+		if fn := runtime.FuncForPC(pc - 1); fn != nil && fn.Name() == "runtime.gopanic" {
+			start = i + 1
+		}
+	}
+
+	frames := make([]uintptr, 0, depth)
+	for _, pc := range pcs[start:n] {
+		if fn := runtime.FuncForPC(pc - 1); fn != nil && isPanicMachinery(fn.Name()) {
+			continue
+		}
+		if len(frames) == depth {
+			return frames, true
+		}
+		frames = append(frames, pc)
+	}
+
+	return frames, n == len(pcs)
+}
+
+// packagePath is the import path of this package as it appears in function
+// names, which differs from the module path when the package is vendored or forked.
+var packagePath string //nolint:gochecknoglobals
+
+// init derives packagePath from panicCallers. A variable initializer would
+// form an initialization cycle through isPanicMachinery.
+func init() { //nolint:gochecknoinits
+	name := runtime.FuncForPC(reflect.ValueOf(panicCallers).Pointer()).Name()
+	packagePath = strings.TrimSuffix(name, ".panicCallers")
+}
+
+// isPanicMachinery reports whether a function belongs to the Go runtime or to
+// the recovery helpers of this package.
+func isPanicMachinery(name string) bool {
+	return strings.HasPrefix(name, "runtime.") ||
+		strings.HasPrefix(name, packagePath+".panicCallers") ||
+		strings.HasPrefix(name, packagePath+".RecoverValue")
+}
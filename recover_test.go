@@ -0,0 +1,121 @@
+package errorsx_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type RecoverSuite struct {
+	suite.Suite
+}
+
+func panicWithValue(v any) (err error) {
+	defer errorsx.Recover(&err)
+	panic(v)
+}
+
+func panicWithNilMap() (err error) {
+	defer errorsx.Recover(&err)
+	var m map[string]int
+	m["boom"] = 1
+	return nil
+}
+
+func (s *RecoverSuite) TestRecoverNonErrorValue() {
+	err := panicWithValue("something bad")
+
+	s.Require().Error(err)
+	s.Require().True(errors.Is(err, errorsx.ErrPanic))
+	s.Require().Equal("panic: something bad", err.Error())
+
+	var perr *errorsx.PanicError
+	s.Require().True(errors.As(err, &perr))
+	s.Require().Equal("something bad", perr.Value)
+
+	v, ok := errorsx.PanicValue(err)
+	s.Require().True(ok)
+	s.Require().Equal("something bad", v)
+}
+
+func (s *RecoverSuite) TestRecoverErrorValue() {
+	cause := errors.New("root cause")
+	err := panicWithValue(cause)
+
+	s.Require().True(errors.Is(err, errorsx.ErrPanic))
+	s.Require().True(errors.Is(err, cause))
+
+	v, ok := errorsx.PanicValue(err)
+	s.Require().True(ok)
+	s.Require().Equal(cause, v)
+}
+
+func (s *RecoverSuite) TestRecoverCapturesPanickingStack() {
+	err := panicWithNilMap()
+
+	trace := errorsx.FullStackTrace(err)
+	s.Require().Contains(trace, "panicWithNilMap")
+	s.Require().NotContains(trace, "runtime.")
+	s.Require().NotContains(trace, "errorsx.Recover")
+}
+
+func (s *RecoverSuite) TestRecoverValueOutsidePanic() {
+	err := errorsx.RecoverValue("boom")
+
+	frame, _ := runtime.CallersFrames(err.StackFrames()).Next()
+	s.Require().Contains(frame.Function, "TestRecoverValueOutsidePanic")
+	s.Require().NotContains(errorsx.FullStackTrace(err), "errorsx.RecoverValue")
+	s.Require().NotContains(errorsx.FullStackTrace(err), "errorsx.panicCallers")
+}
+
+func (s *RecoverSuite) TestRecoverWithoutPanic() {
+	s.Require().Nil(errorsx.RecoverValue(nil))
+
+	err := func() (err error) {
+		defer errorsx.Recover(&err)
+		return errors.New("regular failure")
+	}()
+	s.Require().EqualError(err, "regular failure")
+}
+
+func (s *RecoverSuite) TestGoForwardsPanic() {
+	errs := make(chan error, 1)
+	errorsx.Go(func() error {
+		panic("worker crashed")
+	}, func(err error) {
+		errs <- err
+	})
+
+	select {
+	case err := <-errs:
+		s.Require().True(errors.Is(err, errorsx.ErrPanic))
+		s.Require().Contains(errorsx.FullStackTrace(err), "TestGoForwardsPanic")
+	case <-time.After(time.Second):
+		s.FailNow("handler was not called")
+	}
+}
+
+func (s *RecoverSuite) TestGoForwardsReturnedError() {
+	errs := make(chan error, 1)
+	want := errorsx.New("worker.failed")
+	errorsx.Go(func() error {
+		return want
+	}, func(err error) {
+		errs <- err
+	})
+
+	select {
+	case err := <-errs:
+		s.Require().Equal(want, err)
+	case <-time.After(time.Second):
+		s.FailNow("handler was not called")
+	}
+}
+
+func TestRecoverSuite(t *testing.T) {
+	suite.Run(t, new(RecoverSuite))
+}
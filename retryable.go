@@ -1,6 +1,9 @@
 package errorsx
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // WithRetryable returns a copy of the error marked as retryable.
 // This indicates that the operation that caused the error can be safely retried.
@@ -11,15 +14,42 @@ import "errors"
 //		WithRetryable().
 //		WithHTTPStatus(503)
 func (e *Error) WithRetryable() *Error {
-	clone := *e
+	clone := e.clone()
 	clone.isRetryable = true
-	return &clone
+	return clone
 }
 
 // IsRetryable returns true if this error represents a retryable condition.
 // This provides a semantic way to check if an operation can be safely retried.
+//
+// Errors not explicitly marked as retryable fall back to the default of their
+// type (see RegisterTypeInfo).
 func (e *Error) IsRetryable() bool {
-	return e.isRetryable
+	if retryable := e.override().Retryable; retryable != nil {
+		return *retryable
+	}
+	return e.isRetryable || e.typeInfo().Retryable
+}
+
+// WithRetryAfter returns a copy of the error marked as retryable with a hint
+// describing how long the caller should wait before retrying.
+//
+// Example:
+//
+//	err := errorsx.New("rate.limit.exceeded").
+//		WithRetryAfter(30 * time.Second).
+//		WithHTTPStatus(429)
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	clone := e.clone()
+	clone.isRetryable = true
+	clone.retryAfter = d
+	return clone
+}
+
+// RetryAfter returns the retry-after hint of this error.
+// Returns 0 if no hint was set.
+func (e *Error) RetryAfter() time.Duration {
+	return e.retryAfter
 }
 
 // NewRetryable creates a new retryable error with the given ID.
@@ -31,13 +61,16 @@ func (e *Error) IsRetryable() bool {
 //	err := errorsx.NewRetryable("connection.timeout")
 //	// Equivalent to: errorsx.New("connection.timeout").WithRetryable()
 func NewRetryable(idOrMsg string) *Error {
-	return New(idOrMsg).WithRetryable()
+	return DefaultFactory().NewRetryable(idOrMsg)
 }
 
 // IsRetryable checks if any error in the error chain represents a retryable condition.
 // This function works with any error type and traverses the error chain to find
 // errorsx.Error instances marked as retryable.
 //
+// Joined errors, such as those returned by Join or errors.Join, are retryable
+// only if every joined error is retryable.
+//
 // Example:
 //
 //	if errorsx.IsRetryable(err) {
@@ -47,12 +80,44 @@ func NewRetryable(idOrMsg string) *Error {
 //
 // Returns false if err is nil or no retryable errors are found in the chain.
 func IsRetryable(err error) bool {
-	if err == nil {
-		return false
-	}
-	var e *Error
-	if errors.As(err, &e) {
-		return e.IsRetryable()
+	for err != nil {
+		switch e := err.(type) {
+		case *Error:
+			return e.IsRetryable()
+		case *MultiError:
+			return e.IsRetryable()
+		case interface{ Unwrap() []error }:
+			errs := e.Unwrap()
+			for _, child := range errs {
+				if !IsRetryable(child) {
+					return false
+				}
+			}
+			return len(errs) > 0
+		}
+		err = errors.Unwrap(err)
 	}
 	return false
 }
+
+// RetryAfter extracts the retry-after hint from the first errorsx.Error in the
+// error tree that carries one.
+//
+// Example:
+//
+//	if d, ok := errorsx.RetryAfter(err); ok {
+//		w.Header().Set("Retry-After", strconv.Itoa(int(d.Seconds())))
+//	}
+//
+// Returns false if err is nil or no hint is found in the chain.
+func RetryAfter(err error) (time.Duration, bool) {
+	var d time.Duration
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && e.retryAfter > 0 {
+			d = e.retryAfter
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return d, d > 0
+}
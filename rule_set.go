@@ -0,0 +1,327 @@
+package errorsx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// InvalidRulesID is the ID of errors returned when a rules file cannot be loaded.
+const InvalidRulesID = "errorsx.invalid_rules"
+
+// RuleSet holds override rules loaded from a JSON rules file. Its rules can be
+// swapped at runtime with Reload while errors are being classified
+// concurrently.
+//
+// A rules file lists rules that are evaluated in order; the first rule whose
+// conditions all match an error applies its overrides:
+//
+//	{
+//	  "rules": [
+//	    {
+//	      "name": "gateway outages are retryable",
+//	      "match": {"id": "payment.gateway.**"},
+//	      "set": {"type": "errorsx.unavailable", "status": 503, "retryable": true, "log_level": "WARN"}
+//	    },
+//	    {
+//	      "match": {"cause_type": "*net.OpError", "status": 500},
+//	      "set": {"status": 502}
+//	    }
+//	  ]
+//	}
+//
+// Match conditions:
+//   - id: a segment glob over the error ID, with the syntax of Rule.Pattern
+//   - id_regexp: a regular expression matched against the error ID
+//   - cause_type: the Go type of an error anywhere in the chain, either as
+//     printed by %T ("*net.OpError") or with the full package path ("net.OpError")
+//   - status: the HTTP status explicitly set on the error
+//
+// Overrides:
+//   - type: the ErrorType
+//   - status: the HTTP status
+//   - retryable: whether the error is retryable
+//   - log_level: the slog level, such as "INFO", "WARN" or "ERROR"
+//
+// Installed with SetRuleSet, the overrides take precedence over the values set
+// in code in Type, HTTPStatus, IsRetryable, LogLevel and MarshalJSON.
+type RuleSet struct {
+	compiled atomic.Pointer[compiledRuleSet]
+}
+
+// Override holds the attributes that a matching rule overrides.
+// Nil fields and an empty Type are left unchanged.
+type Override struct {
+	Type       ErrorType   `json:"type,omitempty"`
+	HTTPStatus *int        `json:"status,omitempty"`
+	Retryable  *bool       `json:"retryable,omitempty"`
+	LogLevel   *slog.Level `json:"log_level,omitempty"`
+}
+
+// RuleMatch holds the conditions of a rule. Every non-empty condition must match.
+type RuleMatch struct {
+	ID         string `json:"id,omitempty"`
+	IDRegexp   string `json:"id_regexp,omitempty"`
+	CauseType  string `json:"cause_type,omitempty"`
+	HTTPStatus int    `json:"status,omitempty"`
+}
+
+// OverrideRule is a rule of a rules file.
+type OverrideRule struct {
+	Name  string    `json:"name,omitempty"`
+	Match RuleMatch `json:"match"`
+	Set   Override  `json:"set"`
+}
+
+type rulesFile struct {
+	Rules []OverrideRule `json:"rules"`
+}
+
+type compiledRuleSet struct {
+	rules []compiledOverrideRule
+}
+
+type compiledOverrideRule struct {
+	OverrideRule
+	desc     string
+	idGlob   *ruleNode
+	idRegexp *regexp.Regexp
+}
+
+// LoadRules reads a JSON rules file from r and returns a RuleSet holding its rules.
+func LoadRules(r io.Reader) (*RuleSet, error) {
+	rs := &RuleSet{}
+	if err := rs.Reload(r); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// LoadRulesFile reads the JSON rules file at path and returns a RuleSet holding its rules.
+func LoadRulesFile(path string) (*RuleSet, error) {
+	rs := &RuleSet{}
+	if err := rs.ReloadFile(path); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// Reload reads a JSON rules file from r and atomically replaces the rules.
+// If the file is invalid, the current rules are kept and an error is returned.
+//
+// Example:
+//
+//	signal.Notify(hup, syscall.SIGHUP)
+//	go func() {
+//		for range hup {
+//			if err := rules.ReloadFile("/etc/app/error-rules.json"); err != nil {
+//				slog.Error("reloading error rules", "error", err)
+//			}
+//		}
+//	}()
+func (rs *RuleSet) Reload(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return newInternal(InvalidRulesID).WithCause(err).WithReason("reading rules: %v", err)
+	}
+
+	var file rulesFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return newInternal(InvalidRulesID, WithType(TypeValidation)).WithCause(err).WithReason("parsing rules: %v", err)
+	}
+
+	compiled, err := compileOverrideRules(file.Rules)
+	if err != nil {
+		return err
+	}
+
+	rs.compiled.Store(compiled)
+	infererGeneration.Add(1)
+	return nil
+}
+
+// ReloadFile reads the JSON rules file at path and atomically replaces the rules.
+// If the file is invalid, the current rules are kept and an error is returned.
+func (rs *RuleSet) ReloadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return newInternal(InvalidRulesID).WithCause(err).WithReason("opening rules: %v", err)
+	}
+	defer f.Close()
+
+	return rs.Reload(f)
+}
+
+// Rules returns a copy of the current rules.
+func (rs *RuleSet) Rules() []OverrideRule {
+	compiled := rs.compiled.Load()
+	if compiled == nil {
+		return nil
+	}
+	rules := make([]OverrideRule, len(compiled.rules))
+	for i, rule := range compiled.rules {
+		rules[i] = rule.OverrideRule
+	}
+	return rules
+}
+
+// Lookup returns the overrides of the first rule matching e.
+// Returns false if no rule matches.
+func (rs *RuleSet) Lookup(e *Error) (Override, bool) {
+	if rule := rs.match(e); rule != nil {
+		return rule.Set, true
+	}
+	return Override{}, false
+}
+
+// Infer is an ErrorTypeInferer that returns the type override of the first
+// matching rule. It lets a RuleSet classify errors like any other inferer,
+// without overriding explicit types or other attributes.
+func (rs *RuleSet) Infer(e *Error) ErrorType {
+	rule := rs.match(e)
+	if rule == nil || rule.Set.Type == "" {
+		return TypeUnknown
+	}
+	e.recordRule("%s", rule.desc)
+	return rule.Set.Type
+}
+
+func (rs *RuleSet) match(e *Error) *compiledOverrideRule {
+	compiled := rs.compiled.Load()
+	if compiled == nil {
+		return nil
+	}
+	for i := range compiled.rules {
+		if compiled.rules[i].matches(e) {
+			return &compiled.rules[i]
+		}
+	}
+	return nil
+}
+
+func compileOverrideRules(rules []OverrideRule) (*compiledRuleSet, error) {
+	compiled := &compiledRuleSet{rules: make([]compiledOverrideRule, len(rules))}
+	for i, rule := range rules {
+		c := compiledOverrideRule{OverrideRule: rule, desc: rule.Name}
+		if c.desc == "" {
+			c.desc = fmt.Sprintf("rules[%d]", i)
+		}
+		if rule.Match == (RuleMatch{}) {
+			return nil, newInternal(InvalidRulesID, WithType(TypeValidation)).WithReason("%s: match has no conditions", c.desc)
+		}
+		if rule.Set.Type == "" && rule.Set.HTTPStatus == nil && rule.Set.Retryable == nil && rule.Set.LogLevel == nil {
+			return nil, newInternal(InvalidRulesID, WithType(TypeValidation)).WithReason("%s: set has no overrides", c.desc)
+		}
+		if rule.Match.ID != "" {
+			c.idGlob = &ruleNode{}
+			c.idGlob.insert(strings.Split(rule.Match.ID, "."), i)
+		}
+		if rule.Match.IDRegexp != "" {
+			re, err := regexp.Compile(rule.Match.IDRegexp)
+			if err != nil {
+				return nil, newInternal(InvalidRulesID, WithType(TypeValidation)).WithCause(err).WithReason("%s: %v", c.desc, err)
+			}
+			c.idRegexp = re
+		}
+		compiled.rules[i] = c
+	}
+	return compiled, nil
+}
+
+func (r *compiledOverrideRule) matches(e *Error) bool {
+	if r.idGlob != nil {
+		found := false
+		r.idGlob.match(strings.Split(e.id, "."), 0, func(int) { found = true })
+		if !found {
+			return false
+		}
+	}
+	if r.idRegexp != nil && !r.idRegexp.MatchString(e.id) {
+		return false
+	}
+	if r.Match.HTTPStatus != 0 && r.Match.HTTPStatus != e.status {
+		return false
+	}
+	if r.Match.CauseType != "" && !hasCauseType(e, r.Match.CauseType) {
+		return false
+	}
+	return true
+}
+
+// hasCauseType reports whether the chain of e contains an error of the named Go type.
+func hasCauseType(e *Error, name string) bool {
+	found := false
+	Walk(e, func(node error, _ []int, _ int) WalkAction {
+		if fmt.Sprintf("%T", node) == name || reflectErrorType(node) == name {
+			found = true
+			return WalkStop
+		}
+		return WalkContinue
+	})
+	return found
+}
+
+// activeRuleSet is the RuleSet installed with SetRuleSet.
+var activeRuleSet atomic.Pointer[RuleSet] //nolint:gochecknoglobals
+
+// SetRuleSet installs rs so that its overrides apply to every error.
+// A nil rs removes the installed RuleSet.
+//
+// Example:
+//
+//	rules, err := errorsx.LoadRulesFile("/etc/app/error-rules.json")
+//	if err != nil {
+//		return err
+//	}
+//	errorsx.SetRuleSet(rules)
+func SetRuleSet(rs *RuleSet) {
+	activeRuleSet.Store(rs)
+	infererGeneration.Add(1)
+}
+
+// resolvedOverride is the override of an error together with the generation
+// it was looked up with.
+type resolvedOverride struct {
+	rule       *compiledOverrideRule
+	generation uint64
+}
+
+// overrideRule returns the rule of the installed RuleSet that matches the
+// error, or nil. The result is cached like the inferred type.
+func (e *Error) overrideRule() *compiledOverrideRule {
+	// SetRuleSet stores the rule set before bumping the generation, so loading
+	// the generation first never caches an old match under a new generation.
+	generation := infererGeneration.Load()
+	rs := activeRuleSet.Load()
+	if rs == nil {
+		return nil
+	}
+
+	if e.typeCache != nil {
+		if r := e.typeCache.override.Load(); r != nil && r.generation == generation {
+			return r.rule
+		}
+	}
+
+	rule := rs.match(e)
+	if e.typeCache != nil {
+		e.typeCache.override.Store(&resolvedOverride{rule: rule, generation: generation})
+	}
+	return rule
+}
+
+// override returns the overrides that apply to the error.
+func (e *Error) override() Override {
+	if rule := e.overrideRule(); rule != nil {
+		return rule.Set
+	}
+	return Override{}
+}
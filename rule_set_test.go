@@ -0,0 +1,200 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type RuleSetSuite struct {
+	suite.Suite
+}
+
+func (s *RuleSetSuite) TearDownTest() {
+	errorsx.SetRuleSet(nil)
+	errorsx.ClearGlobalTypeInferer()
+}
+
+const gatewayRules = `{
+  "rules": [
+    {
+      "name": "gateway outages",
+      "match": {"id": "payment.gateway.**"},
+      "set": {"type": "errorsx.unavailable", "status": 503, "retryable": true, "log_level": "WARN"}
+    },
+    {
+      "match": {"cause_type": "*net.OpError", "status": 500},
+      "set": {"status": 502}
+    },
+    {
+      "match": {"id_regexp": "^legacy\\."},
+      "set": {"retryable": false}
+    }
+  ]
+}`
+
+func (s *RuleSetSuite) load(rules string) *errorsx.RuleSet {
+	rs, err := errorsx.LoadRules(strings.NewReader(rules))
+	s.Require().NoError(err)
+	return rs
+}
+
+func (s *RuleSetSuite) TestOverrides() {
+	errorsx.SetRuleSet(s.load(gatewayRules))
+
+	err := errorsx.New("payment.gateway.timeout", errorsx.WithType(errorsx.TypeInternal), errorsx.WithHTTPStatus(500))
+	s.Equal(errorsx.TypeUnavailable, err.Type())
+	s.Equal(503, err.HTTPStatus())
+	s.True(err.IsRetryable())
+	s.Equal(slog.LevelWarn, err.LogLevel())
+	s.Equal(slog.LevelWarn, errorsx.LogLevelOf(fmt.Errorf("wrapped: %w", err)))
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	var result map[string]any
+	s.Require().NoError(json.Unmarshal(data, &result))
+	s.Equal("errorsx.unavailable", result["type"])
+	s.Equal(float64(503), result["status"])
+	s.Equal(true, result["is_retryable"])
+}
+
+func (s *RuleSetSuite) TestCauseTypeAndStatus() {
+	errorsx.SetRuleSet(s.load(gatewayRules))
+
+	cause := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("refused")}
+	err := errorsx.New("inventory.fetch_failed", errorsx.WithHTTPStatus(500)).WithCause(fmt.Errorf("fetch: %w", cause))
+	s.Equal(502, err.HTTPStatus())
+
+	withoutStatus := errorsx.New("inventory.fetch_failed").WithCause(cause)
+	s.Equal(0, withoutStatus.HTTPStatus())
+
+	legacy := errorsx.New("legacy.timeout", errorsx.WithRetryable())
+	s.False(legacy.IsRetryable())
+}
+
+func (s *RuleSetSuite) TestInferer() {
+	rs := s.load(gatewayRules)
+	errorsx.SetGlobalTypeInferer(rs.Infer)
+
+	s.Equal(errorsx.TypeUnavailable, errorsx.New("payment.gateway.timeout").Type())
+	s.Equal(errorsx.TypeInternal, errorsx.New("payment.gateway.timeout", errorsx.WithType(errorsx.TypeInternal)).Type())
+	// Only the type is inferred; the status comes from the type, not from the rule.
+	s.Equal(503, errorsx.New("payment.gateway.timeout").HTTPStatus())
+
+	x := errorsx.ExplainType(errorsx.New("payment.gateway.timeout"))
+	s.Equal("gateway outages", x.Steps[len(x.Steps)-1].Rule)
+}
+
+func (s *RuleSetSuite) TestExplainType() {
+	errorsx.SetRuleSet(s.load(gatewayRules))
+
+	x := errorsx.ExplainType(errorsx.New("payment.gateway.timeout", errorsx.WithType(errorsx.TypeInternal)))
+	s.Equal(errorsx.TypeUnavailable, x.Type)
+	s.Require().Len(x.Steps, 1)
+	s.Equal(errorsx.SourceOverride, x.Steps[0].Source)
+	s.Equal("gateway outages", x.Steps[0].Rule)
+	s.Contains(x.String(), "rule set override: errorsx.unavailable [rule gateway outages] (decided)")
+}
+
+func (s *RuleSetSuite) TestLookup() {
+	rs := s.load(gatewayRules)
+
+	o, ok := rs.Lookup(errorsx.New("payment.gateway.down"))
+	s.True(ok)
+	s.Equal(errorsx.TypeUnavailable, o.Type)
+	s.Require().NotNil(o.HTTPStatus)
+	s.Equal(503, *o.HTTPStatus)
+
+	_, ok = rs.Lookup(errorsx.New("order.failed"))
+	s.False(ok)
+	s.Len(rs.Rules(), 3)
+}
+
+func (s *RuleSetSuite) TestReload() {
+	rs := s.load(gatewayRules)
+	errorsx.SetRuleSet(rs)
+
+	err := errorsx.New("payment.gateway.timeout")
+	s.Equal(503, err.HTTPStatus())
+
+	s.Require().NoError(rs.Reload(strings.NewReader(`{"rules": [{"match": {"id": "payment.**"}, "set": {"status": 504}}]}`)))
+	s.Equal(504, err.HTTPStatus())
+	s.Equal(errorsx.TypeUnknown, err.Type())
+}
+
+func (s *RuleSetSuite) TestReloadKeepsRulesOnError() {
+	rs := s.load(gatewayRules)
+
+	tests := []struct {
+		name  string
+		rules string
+	}{
+		{"invalid JSON", `{"rules": [`},
+		{"unknown field", `{"rules": [{"match": {"idd": "x"}, "set": {"status": 500}}]}`},
+		{"no conditions", `{"rules": [{"match": {}, "set": {"status": 500}}]}`},
+		{"no overrides", `{"rules": [{"match": {"id": "x"}, "set": {}}]}`},
+		{"invalid regexp", `{"rules": [{"match": {"id_regexp": "("}, "set": {"status": 500}}]}`},
+		{"invalid log level", `{"rules": [{"match": {"id": "x"}, "set": {"log_level": "LOUD"}}]}`},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := rs.Reload(strings.NewReader(tt.rules))
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, errorsx.New(errorsx.InvalidRulesID))
+			s.Len(rs.Rules(), 3)
+		})
+	}
+}
+
+func (s *RuleSetSuite) TestLoadRulesFile() {
+	path := filepath.Join(s.T().TempDir(), "rules.json")
+	s.Require().NoError(os.WriteFile(path, []byte(gatewayRules), 0o600))
+
+	rs, err := errorsx.LoadRulesFile(path)
+	s.Require().NoError(err)
+	s.Len(rs.Rules(), 3)
+
+	_, err = errorsx.LoadRulesFile(filepath.Join(s.T().TempDir(), "missing.json"))
+	s.Require().Error(err)
+}
+
+func (s *RuleSetSuite) TestConcurrentReload() {
+	rs := s.load(gatewayRules)
+	errorsx.SetRuleSet(rs)
+	variants := []string{
+		`{"rules": [{"match": {"id": "payment.**"}, "set": {"type": "errorsx.timeout"}}]}`,
+		gatewayRules,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				typ := errorsx.New("payment.gateway.timeout").Type()
+				if typ != errorsx.TypeTimeout && typ != errorsx.TypeUnavailable {
+					s.Failf("unexpected type", "%s", typ)
+				}
+			}
+		}()
+	}
+	for j := 0; j < 50; j++ {
+		s.Require().NoError(rs.Reload(strings.NewReader(variants[j%2])))
+	}
+	wg.Wait()
+}
+
+func TestRuleSetSuite(t *testing.T) {
+	suite.Run(t, new(RuleSetSuite))
+}
@@ -1,19 +1,34 @@
 package errorsx
 
 import (
-	"errors"
 	"fmt"
 	"runtime"
 	"strings"
 )
 
 const (
-	// MaxStackFrames defines the maximum number of stack frames to capture
-	// when creating a stack trace. This prevents excessive memory usage
+	// MaxStackFrames defines the default maximum number of stack frames to
+	// capture when creating a stack trace. This prevents excessive memory usage
 	// while still providing sufficient debugging information.
+	// Config.MaxStackFrames and WithStackDepth change the limit.
 	MaxStackFrames = 32
 )
 
+// StackCaptureMode selects how much of the call stack errors capture.
+type StackCaptureMode int
+
+const (
+	// StackCaptureFull captures up to the configured number of frames. This is the default.
+	StackCaptureFull StackCaptureMode = iota
+
+	// StackCaptureCaller captures only the frame of the caller, which is cheap
+	// enough for hot paths and still locates the error.
+	StackCaptureCaller
+
+	// StackCaptureOff captures no stack traces.
+	StackCaptureOff
+)
+
 // StackTrace represents a captured call stack with an associated message.
 // It stores the raw program counter values and a descriptive message
 // about when/why the stack trace was captured.
@@ -26,6 +41,10 @@ type StackTrace struct {
 
 	// Msg is a descriptive message about when this stack trace was captured.
 	Msg string
+
+	// Truncated reports whether the stack had more frames than were captured.
+	// Caller-only stacks (StackCaptureCaller) are never marked as truncated.
+	Truncated bool
 }
 
 // StackTraceCleaner is a function type for customizing stack trace output.
@@ -73,10 +92,12 @@ func (e *Error) WithStack(skip int) *Error {
 		return e
 	}
 
-	clone := *e
-	clone.stacks = append([]StackTrace{{Frames: callersWithSkip(skip), Msg: e.msg}}, clone.stacks...)
+	clone := e.clone()
+	if st, ok := e.captureStack(skip); ok {
+		clone.stacks = append([]StackTrace{st}, clone.stacks...)
+	}
 	clone.isStacked = true
-	return &clone
+	return clone
 }
 
 // WithCallerStack returns a copy of the error with a stack trace captured from the caller's location.
@@ -120,9 +141,9 @@ func (e *Error) WithCallerStack() *Error {
 //		WithCallerStack().
 //		WithStackTraceCleaner(cleaner)
 func (e *Error) WithStackTraceCleaner(cleaner StackTraceCleaner) *Error {
-	clone := *e
+	clone := e.clone()
 	clone.stackTraceCleaner = cleaner
-	return &clone
+	return clone
 }
 
 // WithCause returns a copy of the error with the specified underlying cause.
@@ -154,9 +175,11 @@ func (e *Error) WithCause(cause error) *Error {
 		return e
 	}
 
-	clone := *e
+	clone := e.clone()
 	clone.cause = cause
-	clone.stacks = append([]StackTrace{{Frames: callers(), Msg: e.msg}}, clone.stacks...)
+	if st, ok := e.captureStack(0); ok {
+		clone.stacks = append([]StackTrace{st}, clone.stacks...)
+	}
 	clone.isStacked = true
 
 	// If the cause error is of type *Error, also keep its stack trace
@@ -164,18 +187,71 @@ func (e *Error) WithCause(cause error) *Error {
 		clone.stacks = append(clone.stacks, causeErr.stacks...)
 	}
 
-	return &clone
+	return clone
+}
+
+// WithStackDepth returns a copy of the error that captures at most depth
+// frames in the stack traces added by WithStack and WithCause, overriding
+// Config.MaxStackFrames. A depth of zero or less restores the
+// Config.MaxStackFrames of the factory that created the error.
+//
+// Example:
+//
+//	// Keep the frames below a deep framework stack
+//	err := errorsx.New("handler.failed").WithStackDepth(128).WithCause(cause)
+func (e *Error) WithStackDepth(depth int) *Error {
+	clone := e.clone()
+	clone.maxStackFrames = depth
+	return clone
+}
+
+// WithStackCapture returns a copy of the error that captures stack traces in
+// the given mode, overriding Config.StackCapture.
+//
+// Example:
+//
+//	// Skip the stack capture on a hot path
+//	return errorsx.New("cache.miss").WithStackCapture(errorsx.StackCaptureOff).WithCause(err)
+func (e *Error) WithStackCapture(mode StackCaptureMode) *Error {
+	clone := e.clone()
+	clone.stackCapture = mode
+	return clone
 }
 
-func callersWithSkip(skip int) []uintptr {
-	const depth = MaxStackFrames
-	var pcs [depth]uintptr
-	n := runtime.Callers(3+skip, pcs[:])
-	return pcs[:n]
+// stackDepth returns the number of frames to capture for the error, or zero
+// if stack capture is off.
+func (e *Error) stackDepth() int {
+	switch e.stackCapture {
+	case StackCaptureOff:
+		return 0
+	case StackCaptureCaller:
+		return 1
+	default:
+		if e.maxStackFrames > 0 {
+			return e.maxStackFrames
+		}
+		return e.config().MaxStackFrames
+	}
 }
 
-func callers() []uintptr {
-	return callersWithSkip(1)
+// truncated reports whether a stack that had more frames than were captured
+// is marked as truncated. Caller-only stacks are partial by design.
+func (e *Error) truncated(more bool) bool {
+	return more && e.stackCapture != StackCaptureCaller
+}
+
+// captureStack captures the stack of the caller of its caller, skipping skip
+// more frames. Returns false if stack capture is off.
+func (e *Error) captureStack(skip int) (StackTrace, bool) {
+	depth := e.stackDepth()
+	if depth == 0 {
+		return StackTrace{}, false
+	}
+
+	// One extra frame tells whether the stack was truncated.
+	pcs := make([]uintptr, depth+1)
+	n := runtime.Callers(3+skip, pcs)
+	return StackTrace{Frames: pcs[:min(n, depth)], Msg: e.msg, Truncated: e.truncated(n > depth)}, true
 }
 
 // Stacks returns the stack traces associated with the error.
@@ -198,49 +274,58 @@ func (e *Error) StackFrames() []uintptr {
 }
 
 // RootCause returns the deepest error in the error chain.
-// If an *Error with a cause is found, it follows the cause; otherwise, it unwraps.
+// It follows the chain with Walk; for joined errors the first branch is followed.
 // Returns the last error in the chain (the root cause).
 func RootCause(err error) error {
 	var last error
-	for err != nil {
-		last = err
-		if e, ok := err.(*Error); ok && e.cause != nil {
-			err = e.cause
-		} else {
-			err = errors.Unwrap(err)
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		last = node
+		if !hasChildren(node) {
+			return WalkStop
 		}
-	}
+		return WalkContinue
+	})
 	return last
 }
 
 // RootStackTrace returns the stack trace of the root cause error, if available.
+// It uses the oldest stack trace of the first errorsx.Error with stack traces
+// found by Walk, which includes the stack traces inherited from its causes.
 func RootStackTrace(err error) string {
-	for err != nil {
-		if e, ok := err.(*Error); ok && len(e.stacks) > 0 {
-			return formatStackTrace(e.stacks[len(e.stacks)-1])
+	var trace string
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok && len(e.stacks) > 0 {
+			trace = formatStackTrace(e.stacks[len(e.stacks)-1])
+			return WalkStop
 		}
-		err = errors.Unwrap(err)
-	}
-	return ""
+		return WalkContinue
+	})
+	return trace
 }
 
 // FullStackTrace returns the full stack trace chain for the error.
+// Every errorsx.Error in the error tree, including the children of joined
+// errors, contributes its stack traces in the order visited by Walk.
 func FullStackTrace(err error) string {
 	var b strings.Builder
-	for err != nil {
-		if e, ok := err.(*Error); ok {
+	Walk(err, func(node error, _ []int, _ int) WalkAction {
+		if e, ok := node.(*Error); ok {
 			for i := len(e.stacks) - 1; i >= 0; i-- {
 				fmt.Fprintf(&b, "\n--- stack (msg: %s) ---\n", e.stacks[i].Msg)
 				b.WriteString(formatStackTrace(e.stacks[i]))
 			}
 		}
-		err = errors.Unwrap(err)
-	}
+		return WalkContinue
+	})
 	return strings.TrimRight(b.String(), "\n")
 }
 
 func formatStackTrace(st StackTrace) string {
-	return strings.Join(toStackTraceLines(st), "\n")
+	s := strings.Join(toStackTraceLines(st), "\n")
+	if st.Truncated {
+		s += "\n... (truncated)"
+	}
+	return s
 }
 
 func toStackTraceLines(st StackTrace) []string {
@@ -0,0 +1,131 @@
+package errorsx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type StackSuite struct {
+	suite.Suite
+}
+
+func (s *StackSuite) TearDownTest() {
+	errorsx.SetDefaultFactory(nil)
+}
+
+// recurse calls fn n frames deeper.
+func recurse(n int, fn func() *errorsx.Error) *errorsx.Error {
+	if n == 0 {
+		return fn()
+	}
+	return recurse(n-1, fn)
+}
+
+func (s *StackSuite) TestTruncated() {
+	err := recurse(40, func() *errorsx.Error { return errorsx.New("deep.failed").WithCallerStack() })
+	s.Require().Len(err.Stacks(), 1)
+	s.Len(err.Stacks()[0].Frames, errorsx.MaxStackFrames)
+	s.True(err.Stacks()[0].Truncated)
+	s.True(strings.HasSuffix(errorsx.FullStackTrace(err), "... (truncated)"))
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	s.Contains(string(data), `"truncated":true`)
+
+	deeper := recurse(40, func() *errorsx.Error {
+		return errorsx.New("deep.failed", errorsx.WithStackDepth(1000)).WithCause(errors.New("cause"))
+	})
+	s.Greater(len(deeper.Stacks()[0].Frames), 40)
+	s.False(deeper.Stacks()[0].Truncated)
+	s.NotContains(errorsx.FullStackTrace(deeper), "truncated")
+
+	data, marshalErr = json.Marshal(deeper)
+	s.Require().NoError(marshalErr)
+	s.NotContains(string(data), "truncated")
+}
+
+func (s *StackSuite) TestDepth() {
+	f := errorsx.NewFactory(errorsx.Config{MaxStackFrames: 3})
+
+	s.Len(f.New("db.failed").WithCallerStack().Stacks()[0].Frames, 3)
+	s.Len(f.New("db.failed", errorsx.WithStackDepth(2)).WithCallerStack().Stacks()[0].Frames, 2)
+	s.Len(f.New("db.failed").WithStackDepth(4).WithCause(errors.New("cause")).Stacks()[0].Frames, 4)
+	restored := recurse(40, func() *errorsx.Error {
+		return f.New("db.failed").WithStackDepth(10).WithStackDepth(0).WithCallerStack()
+	})
+	s.Len(restored.Stacks()[0].Frames, 3)
+	s.Len(f.New("db.failed", errorsx.WithStackDepth(0)).WithCallerStack().Stacks()[0].Frames, 3)
+}
+
+func (s *StackSuite) TestCallerOnly() {
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{StackCapture: errorsx.StackCaptureCaller}))
+
+	err := errorsx.New("db.failed").WithCause(errors.New("cause"))
+	s.Require().Len(err.Stacks(), 1)
+	s.Require().Len(err.Stacks()[0].Frames, 1)
+	s.False(err.Stacks()[0].Truncated)
+	s.NotContains(errorsx.FullStackTrace(err), "truncated")
+
+	data, marshalErr := json.Marshal(err)
+	s.Require().NoError(marshalErr)
+	s.NotContains(string(data), "truncated")
+
+	frame, _ := runtime.CallersFrames(err.StackFrames()).Next()
+	s.Contains(frame.Function, "TestCallerOnly")
+
+	var recovered error
+	func() {
+		defer errorsx.Recover(&recovered)
+		panic("boom")
+	}()
+	var xerr *errorsx.Error
+	s.Require().ErrorAs(recovered, &xerr)
+	s.Require().Len(xerr.Stacks()[0].Frames, 1)
+	s.False(xerr.Stacks()[0].Truncated)
+
+	full := errorsx.New("db.failed", errorsx.WithStackCapture(errorsx.StackCaptureFull)).WithCallerStack()
+	s.Greater(len(full.Stacks()[0].Frames), 1)
+}
+
+func (s *StackSuite) TestOff() {
+	cause := errorsx.New("db.query_failed").WithCallerStack()
+	err := errorsx.New("user.fetch_failed").WithStackCapture(errorsx.StackCaptureOff).WithCause(cause)
+
+	s.Require().ErrorIs(err, cause)
+	s.Equal(cause.Stacks(), err.Stacks())
+	s.Empty(errorsx.New("db.failed", errorsx.WithStackCapture(errorsx.StackCaptureOff)).WithCallerStack().Stacks())
+
+	f := errorsx.NewFactory(errorsx.Config{StackCapture: errorsx.StackCaptureOff})
+	s.Empty(f.New("db.failed").WithCause(errors.New("cause")).Stacks())
+}
+
+func (s *StackSuite) TestRecover() {
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{MaxStackFrames: 1}))
+
+	var err error
+	func() {
+		defer errorsx.Recover(&err)
+		panic("boom")
+	}()
+
+	var xerr *errorsx.Error
+	s.Require().ErrorAs(err, &xerr)
+	s.Require().Len(xerr.Stacks(), 1)
+	s.Len(xerr.Stacks()[0].Frames, 1)
+	s.True(xerr.Stacks()[0].Truncated)
+	frame, _ := runtime.CallersFrames(xerr.StackFrames()).Next()
+	s.Contains(frame.Function, "TestRecover")
+
+	errorsx.SetDefaultFactory(errorsx.NewFactory(errorsx.Config{StackCapture: errorsx.StackCaptureOff}))
+	s.Empty(errorsx.RecoverValue("boom").Stacks())
+}
+
+func TestStackSuite(t *testing.T) {
+	suite.Run(t, new(StackSuite))
+}
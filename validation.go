@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // SummaryTranslator is a function type that translates a summary message.
@@ -47,25 +48,22 @@ type FieldError struct {
 // By having an existing *Error internally, it can be easily combined with business layer errors.
 // Implements Error() to satisfy the error interface, and additionally
 // implements MarshalJSON() to return field errors for JSON output.
+//
+// AddFieldError, Error and MarshalJSON are safe for concurrent use, so parallel
+// validators can share one ValidationError. Direct access to FieldErrors is not synchronized.
 type ValidationError struct {
 	BaseError         *Error            `json:"-"`            // Existing errorsx.Error (ID, Type, HTTPStatus, etc.)
 	FieldErrors       []FieldError      `json:"field_errors"` // Error list for each field name
 	summaryTranslator SummaryTranslator // Translator for summary message
 	fieldTranslator   FieldTranslator   // Translator for field error messages
+	mu                sync.Mutex        // Guards FieldErrors
 }
 
 // NewValidationError creates a new instance as a form input validation error.
 //
 //	id: ID that uniquely identifies the validation error (e.g., "validation.failed")
 func NewValidationError(id string) *ValidationError {
-	base := New(id, WithType(TypeValidation))
-
-	return &ValidationError{
-		BaseError:         base,
-		FieldErrors:       nil,
-		summaryTranslator: DefaultSummaryTranslator,
-		fieldTranslator:   DefaultFieldTranslator,
-	}
+	return DefaultFactory().NewValidationError(id)
 }
 
 // WithHTTPStatus sets the HTTP status code for the validation error.
@@ -91,6 +89,9 @@ func (v *ValidationError) WithMessage(data any) *ValidationError {
 //	}
 //	verr.WithSummaryTranslator(customSummary)
 func (v *ValidationError) WithSummaryTranslator(t SummaryTranslator) *ValidationError {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
 	v.summaryTranslator = t
 	return v
 }
@@ -110,6 +111,9 @@ func (v *ValidationError) WithSummaryTranslator(t SummaryTranslator) *Validation
 //	}
 //	verr.WithFieldTranslator(customFieldTranslator)
 func (v *ValidationError) WithFieldTranslator(t FieldTranslator) *ValidationError {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
 	v.fieldTranslator = t
 	return v
 }
@@ -140,6 +144,9 @@ func (v *ValidationError) WithFieldTranslator(t FieldTranslator) *ValidationErro
 //		"ja": "ユーザー名は既に使用されています",
 //	})
 func (v *ValidationError) AddFieldError(field, code string, message any) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
 	v.FieldErrors = append(v.FieldErrors, FieldError{
 		Field:   field,
 		Code:    code,
@@ -154,19 +161,31 @@ func (v *ValidationError) AddFieldError(field, code string, message any) {
 //
 // Example output: "validation failed: email: required; password: too short".
 func (v *ValidationError) Error() string {
-	if len(v.FieldErrors) == 0 {
+	fieldErrors, fieldTranslator, _ := v.snapshot()
+
+	if len(fieldErrors) == 0 {
 		return v.BaseError.msg
 	}
 	// Example: "validation failed: email is required; password is too short"
 	var parts []string
-	for _, fe := range v.FieldErrors {
+	for _, fe := range fieldErrors {
 		// Use field translator to convert message to string
-		msgStr := v.fieldTranslator(fe.Field, fe.Code, fe.Message)
+		msgStr := safeTranslateField(fieldTranslator, v.BaseError, fe.Field, fe.Code, fe.Message)
 		parts = append(parts, fmt.Sprintf("%s: %s", fe.Field, msgStr))
 	}
 	return fmt.Sprintf("%s: %s", v.BaseError.msg, strings.Join(parts, "; "))
 }
 
+// snapshot returns a copy of the field errors and the translators. The
+// translators run on the copy without holding the lock, so that they can
+// call back into the ValidationError.
+func (v *ValidationError) snapshot() ([]FieldError, FieldTranslator, SummaryTranslator) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return append([]FieldError(nil), v.FieldErrors...), v.fieldTranslator, v.summaryTranslator
+}
+
 // Unwrap returns the underlying base error, enabling Go's error unwrapping
 // functionality. This allows ValidationError to participate in error chains
 // and be compatible with errors.Is() and errors.As().
@@ -182,13 +201,13 @@ func (v *ValidationError) Unwrap() error {
 
 // HTTPStatus returns the HTTP status code associated with this validation error.
 // This is typically used by web frameworks to set appropriate HTTP response codes.
-// Defaults to 0 if no status was explicitly set.
+// Defaults to the status registered for TypeValidation (400) if no status was explicitly set.
 //
 // Common validation error status codes:
 //   - 400 Bad Request: General validation failures
 //   - 422 Unprocessable Entity: Semantic validation errors
 func (v *ValidationError) HTTPStatus() int {
-	return v.BaseError.status
+	return v.BaseError.HTTPStatus()
 }
 
 // MarshalJSON implements json.Marshaler to provide custom JSON serialization.
@@ -243,14 +262,16 @@ func (v *ValidationError) MarshalJSON() ([]byte, error) {
 		FieldErrors []fieldErrorWithTranslation `json:"field_errors"`
 	}
 
+	snapshot, fieldTranslator, summaryTranslator := v.snapshot()
+
 	// Create field errors with translated messages
-	fieldErrors := make([]fieldErrorWithTranslation, len(v.FieldErrors))
-	for i, fe := range v.FieldErrors {
+	fieldErrors := make([]fieldErrorWithTranslation, len(snapshot))
+	for i, fe := range snapshot {
 		fieldErrors[i] = fieldErrorWithTranslation{
 			Field:             fe.Field,
 			Code:              fe.Code,
 			Message:           fe.Message,
-			TranslatedMessage: v.fieldTranslator(fe.Field, fe.Code, fe.Message),
+			TranslatedMessage: safeTranslateField(fieldTranslator, v.BaseError, fe.Field, fe.Code, fe.Message),
 		}
 	}
 
@@ -258,7 +279,7 @@ func (v *ValidationError) MarshalJSON() ([]byte, error) {
 		ID:          v.BaseError.id,
 		Type:        v.BaseError.errType,
 		MessageData: v.BaseError.messageData,
-		Message:     v.summaryTranslator(v.FieldErrors, v.BaseError.messageData),
+		Message:     safeTranslateSummary(summaryTranslator, v.BaseError, snapshot, v.BaseError.messageData),
 		FieldErrors: fieldErrors,
 	}
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hacomono-lib/go-errorsx"
 	"github.com/stretchr/testify/assert"
@@ -138,7 +139,7 @@ func (suite *ValidationErrorTestSuite) TestHTTPStatus_DefaultValue() {
 	status := validationErr.HTTPStatus()
 
 	// Assert
-	assert.Equal(suite.T(), 0, status) // BaseError.status is 0 by default after user's modification
+	assert.Equal(suite.T(), 400, status) // Falls back to the default status of TypeValidation
 }
 
 func (suite *ValidationErrorTestSuite) TestWithHTTPStatus() {
@@ -455,3 +456,33 @@ func (suite *ValidationErrorTestSuite) TestTranslatorChaining() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "Custom summary", jsonResult["message"])
 }
+
+func (suite *ValidationErrorTestSuite) TestTranslatorsCanCallBack() {
+	// Arrange
+	validationErr := errorsx.NewValidationError("validation.failed")
+	validationErr.
+		WithFieldTranslator(func(field, code string, message any) string {
+			if field == "email" {
+				validationErr.AddFieldError("audit", "seen", "translated "+field)
+			}
+			return fmt.Sprintf("%v", message)
+		}).
+		WithSummaryTranslator(func(fieldErrors []errorsx.FieldError, messageData any) string {
+			return fmt.Sprintf("%d error(s) in %d bytes", len(fieldErrors), len(validationErr.Error()))
+		})
+	validationErr.AddFieldError("email", "required", "Email is required")
+
+	// Act & Assert
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.Equal(suite.T(), "validation.failed: email: Email is required", validationErr.Error())
+		_, err := json.Marshal(validationErr)
+		assert.NoError(suite.T(), err)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		suite.FailNow("translator calling back into the ValidationError deadlocked")
+	}
+}
@@ -0,0 +1,202 @@
+package errorsx
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// WalkAction tells Walk how to continue after visiting a node.
+type WalkAction int
+
+const (
+	// WalkContinue descends into the children of the current node.
+	WalkContinue WalkAction = iota
+
+	// WalkSkip skips the children of the current node but continues with its siblings.
+	WalkSkip
+
+	// WalkStop ends the walk immediately.
+	WalkStop
+)
+
+// WalkFunc is called by Walk for every node of an error tree.
+//
+// Parameters:
+//   - node: The error being visited
+//   - path: The child indexes leading from the root to node. The root has an
+//     empty path, the cause of an unwrapping error has index 0, and the
+//     children of a joined error have their position as index. The slice is
+//     reused between calls and must be copied to be retained.
+//   - depth: The number of edges between the root and node (len(path))
+type WalkFunc func(node error, path []int, depth int) WalkAction
+
+// Walk traverses the error tree rooted at err in depth-first pre-order and
+// calls fn for every node. Errors with an Unwrap() error method have one
+// child, errors with an Unwrap() []error method (such as joined errors) have
+// one child per wrapped error.
+//
+// Every node is visited exactly once: a node reached again through another
+// branch, or through a cycle, is not visited or descended into a second time.
+// Nodes are identified by pointer identity, so error values that are not
+// pointers, which cannot form cycles by themselves, are never treated as
+// already visited.
+//
+// Example:
+//
+//	errorsx.Walk(err, func(node error, path []int, depth int) errorsx.WalkAction {
+//		fmt.Printf("%s%v %s\n", strings.Repeat("  ", depth), path, node)
+//		if errors.Is(node, context.Canceled) {
+//			return errorsx.WalkStop
+//		}
+//		return errorsx.WalkContinue
+//	})
+func Walk(err error, fn WalkFunc) {
+	if err == nil {
+		return
+	}
+	w := walker{fn: fn}
+	// A chain without branches or cycles reaches every node once by itself,
+	// so visited nodes are only tracked for other trees.
+	n, chain := chainLength(err)
+	if !chain {
+		w.seen = map[walkKey]struct{}{}
+	}
+
+	path := pathBuffers.Get().(*[]int) //nolint:forcetypeassert
+	if cap(*path) < n {
+		*path = make([]int, 0, n)
+	}
+	w.walk(err, (*path)[:0])
+	pathBuffers.Put(path)
+}
+
+// pathBuffers holds the path slices passed to WalkFunc, which must not retain them.
+var pathBuffers = sync.Pool{New: func() any { return new([]int) }} //nolint:gochecknoglobals
+
+type walker struct {
+	fn WalkFunc
+	// seen holds the visited pointer nodes, or is nil when err is a chain.
+	seen map[walkKey]struct{}
+}
+
+// walkKey identifies a pointer node. The type distinguishes pointers to
+// different types at the same address, such as a struct and its first field.
+type walkKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// walk visits node and its descendants. It returns false when the walk must stop.
+// Single children are followed in a loop rather than by recursion.
+func (w *walker) walk(node error, path []int) bool {
+	for {
+		if !w.firstVisit(node) {
+			return true
+		}
+
+		switch w.fn(node, path, len(path)) {
+		case WalkStop:
+			return false
+		case WalkSkip:
+			return true
+		case WalkContinue:
+		}
+
+		child, children := unwrapNode(node)
+		if children == nil {
+			if child == nil {
+				return true
+			}
+			node, path = child, append(path, 0)
+			continue
+		}
+
+		i := 0
+		for _, child := range children {
+			if child == nil {
+				continue
+			}
+			if !w.walk(child, append(path, i)) {
+				return false
+			}
+			i++
+		}
+		return true
+	}
+}
+
+// firstVisit records node as visited and reports whether it was not visited before.
+func (w *walker) firstVisit(node error) bool {
+	if w.seen == nil {
+		return true
+	}
+	// Comparing other values would panic on comparable types holding
+	// unhashable values in interface fields.
+	key, ok := pointerKey(node)
+	if !ok {
+		return true
+	}
+	if _, ok := w.seen[key]; ok {
+		return false
+	}
+	w.seen[key] = struct{}{}
+	return true
+}
+
+func pointerKey(node error) (walkKey, bool) {
+	v := reflect.ValueOf(node)
+	if v.Kind() != reflect.Pointer {
+		return walkKey{}, false
+	}
+	return walkKey{typ: v.Type(), ptr: v.Pointer()}, true
+}
+
+// chainLength returns the number of nodes of err if every node has at most
+// one child, every node with a child is a pointer and the chain has no cycle.
+// Cycles are found with Brent's algorithm, which needs no memory.
+func chainLength(err error) (int, bool) {
+	var tortoise walkKey
+	steps, limit := 0, 1
+	for n := 1; ; n++ {
+		child, children := unwrapNode(err)
+		if children != nil {
+			return 0, false
+		}
+		if child == nil {
+			return n, true
+		}
+		key, ok := pointerKey(err)
+		if !ok || key == tortoise {
+			return 0, false
+		}
+		if steps++; steps == limit {
+			tortoise, steps, limit = key, 0, limit*2
+		}
+		err = child
+	}
+}
+
+// unwrapNode returns the child of an error with a single wrapped error, or
+// the children, including nil ones, of an error with an Unwrap() []error method.
+// A node with neither is a leaf.
+func unwrapNode(err error) (error, []error) {
+	switch e := err.(type) {
+	case *Error:
+		return e.cause, nil
+	case interface{ Unwrap() []error }:
+		return nil, e.Unwrap()
+	}
+	return errors.Unwrap(err), nil
+}
+
+// hasChildren reports whether err wraps any non-nil error.
+func hasChildren(err error) bool {
+	child, children := unwrapNode(err)
+	for _, c := range children {
+		if c != nil {
+			return true
+		}
+	}
+	return child != nil
+}
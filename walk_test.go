@@ -0,0 +1,162 @@
+package errorsx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hacomono-lib/go-errorsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type WalkSuite struct {
+	suite.Suite
+}
+
+type cyclicError struct {
+	next error
+}
+
+func (c *cyclicError) Error() string { return "cyclic" }
+func (c *cyclicError) Unwrap() error { return c.next }
+
+type visit struct {
+	msg   string
+	path  string
+	depth int
+}
+
+func collectVisits(err error, action func(node error) errorsx.WalkAction) []visit {
+	var visits []visit
+	errorsx.Walk(err, func(node error, path []int, depth int) errorsx.WalkAction {
+		visits = append(visits, visit{msg: node.Error(), path: fmt.Sprint(path), depth: depth})
+		if action != nil {
+			return action(node)
+		}
+		return errorsx.WalkContinue
+	})
+	return visits
+}
+
+func (s *WalkSuite) TestVisitsTreeWithPaths() {
+	root := errors.New("root")
+	inner := errorsx.New("inner").WithCause(root)
+	other := errorsx.New("other")
+	err := fmt.Errorf("outer: %w", errorsx.Join(inner, other))
+
+	s.Equal([]visit{
+		{msg: "outer: inner; other", path: "[]", depth: 0},
+		{msg: "inner; other", path: "[0]", depth: 1},
+		{msg: "inner", path: "[0 0]", depth: 2},
+		{msg: "root", path: "[0 0 0]", depth: 3},
+		{msg: "other", path: "[0 1]", depth: 2},
+	}, collectVisits(err, nil))
+}
+
+func (s *WalkSuite) TestSkipAndStop() {
+	inner := errorsx.New("inner").WithCause(errors.New("root"))
+	err := errorsx.Join(inner, errorsx.New("other"))
+
+	skipped := collectVisits(err, func(node error) errorsx.WalkAction {
+		if node.Error() == "inner" {
+			return errorsx.WalkSkip
+		}
+		return errorsx.WalkContinue
+	})
+	s.Len(skipped, 3)
+	s.Equal("other", skipped[2].msg)
+
+	stopped := collectVisits(err, func(node error) errorsx.WalkAction {
+		if node.Error() == "inner" {
+			return errorsx.WalkStop
+		}
+		return errorsx.WalkContinue
+	})
+	s.Len(stopped, 2)
+}
+
+func (s *WalkSuite) TestVisitsSharedNodeOnce() {
+	shared := errorsx.New("shared")
+	err := errors.Join(shared, fmt.Errorf("wrapped: %w", shared))
+
+	visits := collectVisits(err, nil)
+	s.Len(visits, 3)
+}
+
+func (s *WalkSuite) TestCycleProtection() {
+	a := &cyclicError{}
+	b := &cyclicError{next: a}
+	a.next = b
+
+	s.Len(collectVisits(a, nil), 2)
+	s.NotPanics(func() { errorsx.RootCause(a) })
+
+	// Cycles of any length behind a chain of any length
+	for tail := 0; tail < 5; tail++ {
+		for length := 1; length < 10; length++ {
+			nodes := make([]*cyclicError, tail+length)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				nodes[i] = &cyclicError{}
+				if i+1 < len(nodes) {
+					nodes[i].next = nodes[i+1]
+				}
+			}
+			nodes[len(nodes)-1].next = nodes[tail]
+			s.Len(collectVisits(nodes[0], nil), tail+length)
+		}
+	}
+}
+
+func (s *WalkSuite) TestChainDoesNotAllocate() {
+	var err error = errors.New("root")
+	for i := 0; i < 100; i++ {
+		err = fmt.Errorf("layer: %w", errorsx.New("layer.failed").WithCause(err))
+	}
+
+	s.Zero(testing.AllocsPerRun(100, func() {
+		errorsx.Walk(err, func(error, []int, int) errorsx.WalkAction { return errorsx.WalkContinue })
+	}))
+}
+
+// valueError is comparable, but holds an unhashable value in an interface field.
+type valueError struct {
+	data any
+}
+
+func (e valueError) Error() string { return "value error" }
+
+func (s *WalkSuite) TestUnhashableValueError() {
+	err := errorsx.New("a").WithCause(valueError{data: []int{1}})
+
+	s.NotPanics(func() {
+		s.Len(collectVisits(err, nil), 2)
+		s.Contains(errorsx.FullStackTrace(err), "stack")
+		s.Equal(valueError{data: []int{1}}, errorsx.RootCause(err))
+		s.False(errorsx.HasType(err, errorsx.TypeTimeout))
+		s.True(errorsx.IsID(err, "a"))
+	})
+}
+
+func (s *WalkSuite) TestNilError() {
+	s.Empty(collectVisits(nil, nil))
+	s.Nil(errorsx.RootCause(nil))
+}
+
+func (s *WalkSuite) TestHelpersOnJoinedErrors() {
+	root := errors.New("root")
+	first := errorsx.New("first").WithCause(root)
+	second := errorsx.New("second").WithCallerStack()
+	err := errorsx.Join(first, second)
+
+	s.Equal(root, errorsx.RootCause(err))
+	s.Equal(root, errorsx.RootCause(fmt.Errorf("wrapped: %w", err)))
+
+	trace := errorsx.FullStackTrace(err)
+	s.Contains(trace, "--- stack (msg: first) ---")
+	s.Contains(trace, "--- stack (msg: second) ---")
+	s.NotEmpty(errorsx.RootStackTrace(err))
+}
+
+func TestWalkSuite(t *testing.T) {
+	suite.Run(t, new(WalkSuite))
+}